@@ -37,19 +37,20 @@ type AWSClient struct {
 	Region            string
 	ServicePackages   map[string]ServicePackage
 
-	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	dnsSuffix                 string
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	awsConfig                  *aws_sdkv2.Config
+	clients                    map[string]any
+	conns                      map[string]any
+	dnsSuffix                  string
+	endpoints                  map[string]string // From provider configuration.
+	httpClient                 *http.Client
+	lock                       sync.Mutex
+	logger                     baselogging.Logger
+	session                    *session_sdkv1.Session
+	s3DefaultChecksumAlgorithm string // From provider configuration.
+	s3ExpressClient            *s3_sdkv2.Client
+	s3UsePathStyle             bool   // From provider configuration.
+	s3USEast1RegionalEndpoint  string // From provider configuration.
+	stsRegion                  string // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -160,6 +161,11 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	return c.s3ExpressClient
 }
 
+// S3DefaultChecksumAlgorithm returns the s3_default_checksum_algorithm provider configuration value.
+func (c *AWSClient) S3DefaultChecksumAlgorithm(context.Context) string {
+	return c.s3DefaultChecksumAlgorithm
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
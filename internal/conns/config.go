@@ -46,6 +46,7 @@ type Config struct {
 	Profile                        string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
+	S3DefaultChecksumAlgorithm     string
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
@@ -218,6 +219,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.s3DefaultChecksumAlgorithm = c.S3DefaultChecksumAlgorithm
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
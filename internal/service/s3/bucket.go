@@ -1664,6 +1664,20 @@ func bucketRegionalDomainName(bucket, region string) string {
 	return fmt.Sprintf("%s.s3.%s.%s", bucket, region, names.DNSSuffixForPartition(names.PartitionForRegion(region)))
 }
 
+// bucketRegionFromLocationConstraint returns the region of a bucket from its GetBucketLocation location constraint.
+func bucketRegionFromLocationConstraint(v types.BucketLocationConstraint) string {
+	switch v {
+	// Buckets in us-east-1 have a null location constraint.
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketLocation.html.
+	case "":
+		return names.USEast1RegionID
+	case types.BucketLocationConstraintEu:
+		return names.EUWest1RegionID
+	default:
+		return string(v)
+	}
+}
+
 func bucketWebsiteEndpointAndDomain(bucket, region string) (string, string) {
 	var domain string
 
@@ -2116,19 +2130,15 @@ func expandBucketLifecycleRules(ctx context.Context, l []interface{}) []types.Li
 			result.Expiration = expandBucketLifecycleExpiration(v)
 		}
 
-		var filter types.LifecycleRuleFilter
+		filter := &types.LifecycleRuleFilter{}
 		prefix := tfMap["prefix"].(string)
 		if tags := Tags(tftags.New(ctx, tfMap["tags"]).IgnoreAWS()); len(tags) > 0 {
-			filter = &types.LifecycleRuleFilterMemberAnd{
-				Value: types.LifecycleRuleAndOperator{
-					Prefix: aws.String(prefix),
-					Tags:   tags,
-				},
+			filter.And = &types.LifecycleRuleAndOperator{
+				Prefix: aws.String(prefix),
+				Tags:   tags,
 			}
 		} else {
-			filter = &types.LifecycleRuleFilterMemberPrefix{
-				Value: prefix,
-			}
+			filter.Prefix = aws.String(prefix)
 		}
 		result.Filter = filter
 
@@ -2292,18 +2302,18 @@ func flattenBucketLifecycleRules(ctx context.Context, rules []types.LifecycleRul
 		}
 
 		if filter := rule.Filter; filter != nil {
-			switch v := filter.(type) {
-			case *types.LifecycleRuleFilterMemberAnd:
-				if v := v.Value.Prefix; v != nil {
+			switch {
+			case filter.And != nil:
+				if v := filter.And.Prefix; v != nil {
 					m["prefix"] = aws.ToString(v)
 				}
-				if v := v.Value.Tags; v != nil {
+				if v := filter.And.Tags; v != nil {
 					m["tags"] = keyValueTags(ctx, v).IgnoreAWS().Map()
 				}
-			case *types.LifecycleRuleFilterMemberPrefix:
-				m["prefix"] = v.Value
-			case *types.LifecycleRuleFilterMemberTag:
-				m["tags"] = keyValueTags(ctx, []types.Tag{v.Value}).IgnoreAWS().Map()
+			case filter.Prefix != nil:
+				m["prefix"] = aws.ToString(filter.Prefix)
+			case filter.Tag != nil:
+				m["tags"] = keyValueTags(ctx, []types.Tag{*filter.Tag}).IgnoreAWS().Map()
 			}
 		}
 
@@ -2472,19 +2482,15 @@ func expandBucketReplicationRules(ctx context.Context, l []interface{}) []types.
 		if v, ok := tfRuleMap["filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
 			// XML schema V2.
 			tfFilterMap := v[0].(map[string]interface{})
-			var filter types.ReplicationRuleFilter
+			filter := &types.ReplicationRuleFilter{}
 
 			if tags := Tags(tftags.New(ctx, tfFilterMap["tags"]).IgnoreAWS()); len(tags) > 0 {
-				filter = &types.ReplicationRuleFilterMemberAnd{
-					Value: types.ReplicationRuleAndOperator{
-						Prefix: aws.String(tfFilterMap["prefix"].(string)),
-						Tags:   tags,
-					},
+				filter.And = &types.ReplicationRuleAndOperator{
+					Prefix: aws.String(tfFilterMap["prefix"].(string)),
+					Tags:   tags,
 				}
 			} else {
-				filter = &types.ReplicationRuleFilterMemberPrefix{
-					Value: tfFilterMap["prefix"].(string),
-				}
+				filter.Prefix = aws.String(tfFilterMap["prefix"].(string))
 			}
 
 			rule.Filter = filter
@@ -2722,21 +2728,21 @@ func flattenBucketDestination(dest *types.Destination) []interface{} {
 	return []interface{}{m}
 }
 
-func flattenBucketReplicationRuleFilter(ctx context.Context, filter types.ReplicationRuleFilter) []interface{} {
+func flattenBucketReplicationRuleFilter(ctx context.Context, filter *types.ReplicationRuleFilter) []interface{} {
 	if filter == nil {
 		return []interface{}{}
 	}
 
 	m := make(map[string]interface{})
 
-	switch v := filter.(type) {
-	case *types.ReplicationRuleFilterMemberAnd:
-		m["prefix"] = aws.ToString(v.Value.Prefix)
-		m["tags"] = keyValueTags(ctx, v.Value.Tags).IgnoreAWS().Map()
-	case *types.ReplicationRuleFilterMemberPrefix:
-		m["prefix"] = v.Value
-	case *types.ReplicationRuleFilterMemberTag:
-		m["tags"] = keyValueTags(ctx, []types.Tag{v.Value}).IgnoreAWS().Map()
+	switch {
+	case filter.And != nil:
+		m["prefix"] = aws.ToString(filter.And.Prefix)
+		m["tags"] = keyValueTags(ctx, filter.And.Tags).IgnoreAWS().Map()
+	case filter.Prefix != nil:
+		m["prefix"] = aws.ToString(filter.Prefix)
+	case filter.Tag != nil:
+		m["tags"] = keyValueTags(ctx, []types.Tag{*filter.Tag}).IgnoreAWS().Map()
 	}
 
 	return []interface{}{m}
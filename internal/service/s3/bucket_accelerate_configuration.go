@@ -183,7 +183,7 @@ func resourceBucketAccelerateConfigurationDelete(ctx context.Context, d *schema.
 	return nil
 }
 
-func findBucketAccelerateConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketAccelerateConfigurationOutput, error) {
+func findBucketAccelerateConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, optFns ...func(*s3.Options)) (*s3.GetBucketAccelerateConfigurationOutput, error) {
 	input := &s3.GetBucketAccelerateConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
@@ -191,7 +191,7 @@ func findBucketAccelerateConfiguration(ctx context.Context, conn *s3.Client, buc
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	output, err := conn.GetBucketAccelerateConfiguration(ctx, input)
+	output, err := conn.GetBucketAccelerateConfiguration(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		return nil, &retry.NotFoundError{
@@ -573,8 +573,8 @@ func expandLifecycleRules(ctx context.Context, l []interface{}) []types.Lifecycl
 			// apply the Default behavior from v3.x of the provider;
 			// otherwise, set the prefix as specified in Terraform.
 			if v == "" {
-				result.Filter = &types.LifecycleRuleFilterMemberPrefix{
-					Value: v,
+				result.Filter = &types.LifecycleRuleFilter{
+					Prefix: aws.String(v),
 				}
 			} else {
 				result.Prefix = aws.String(v)
@@ -651,97 +651,93 @@ func expandLifecycleExpiration(l []interface{}) *types.LifecycleExpiration {
 	return result
 }
 
-func expandLifecycleRuleFilter(ctx context.Context, l []interface{}) types.LifecycleRuleFilter {
-	if len(l) == 0 {
+func expandLifecycleRuleFilter(ctx context.Context, l []interface{}) *types.LifecycleRuleFilter {
+	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
-	var result types.LifecycleRuleFilter
-
-	if l[0] == nil {
-		return result
-	}
+	var result *types.LifecycleRuleFilter
 
 	m := l[0].(map[string]interface{})
 
 	if v, ok := m["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		result = expandLifecycleRuleFilterMemberAnd(ctx, v[0].(map[string]interface{}))
+		result = &types.LifecycleRuleFilter{
+			And: expandLifecycleRuleAndOperator(ctx, v[0].(map[string]interface{})),
+		}
 	}
 
 	if v, null, _ := nullable.Int(m["object_size_greater_than"].(string)).Value(); !null && v >= 0 {
-		result = &types.LifecycleRuleFilterMemberObjectSizeGreaterThan{
-			Value: v,
+		result = &types.LifecycleRuleFilter{
+			ObjectSizeGreaterThan: aws.Int64(v),
 		}
 	}
 
 	if v, null, _ := nullable.Int(m["object_size_less_than"].(string)).Value(); !null && v > 0 {
-		result = &types.LifecycleRuleFilterMemberObjectSizeLessThan{
-			Value: v,
+		result = &types.LifecycleRuleFilter{
+			ObjectSizeLessThan: aws.Int64(v),
 		}
 	}
 
 	if v, ok := m["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		result = expandLifecycleRuleFilterMemberTag(v[0].(map[string]interface{}))
+		result = &types.LifecycleRuleFilter{
+			Tag: expandLifecycleRuleFilterTag(v[0].(map[string]interface{})),
+		}
 	}
 
 	// Per AWS S3 API, "A Filter must have exactly one of Prefix, Tag, or And specified";
 	// Specifying more than one of the listed parameters results in a MalformedXML error.
 	// In practice, this also includes ObjectSizeGreaterThan and ObjectSizeLessThan.
 	if v, ok := m["prefix"].(string); ok && result == nil {
-		result = &types.LifecycleRuleFilterMemberPrefix{
-			Value: v,
+		result = &types.LifecycleRuleFilter{
+			Prefix: aws.String(v),
 		}
 	}
 
 	return result
 }
 
-func expandLifecycleRuleFilterMemberAnd(ctx context.Context, m map[string]interface{}) *types.LifecycleRuleFilterMemberAnd {
+func expandLifecycleRuleAndOperator(ctx context.Context, m map[string]interface{}) *types.LifecycleRuleAndOperator {
 	if len(m) == 0 {
 		return nil
 	}
 
-	result := &types.LifecycleRuleFilterMemberAnd{
-		Value: types.LifecycleRuleAndOperator{},
-	}
+	result := &types.LifecycleRuleAndOperator{}
 
 	if v, ok := m["object_size_greater_than"].(int); ok && v > 0 {
-		result.Value.ObjectSizeGreaterThan = aws.Int64(int64(v))
+		result.ObjectSizeGreaterThan = aws.Int64(int64(v))
 	}
 
 	if v, ok := m["object_size_less_than"].(int); ok && v > 0 {
-		result.Value.ObjectSizeLessThan = aws.Int64(int64(v))
+		result.ObjectSizeLessThan = aws.Int64(int64(v))
 	}
 
 	if v, ok := m["prefix"].(string); ok {
-		result.Value.Prefix = aws.String(v)
+		result.Prefix = aws.String(v)
 	}
 
 	if v, ok := m["tags"].(map[string]interface{}); ok && len(v) > 0 {
 		tags := Tags(tftags.New(ctx, v).IgnoreAWS())
 		if len(tags) > 0 {
-			result.Value.Tags = tags
+			result.Tags = tags
 		}
 	}
 
 	return result
 }
 
-func expandLifecycleRuleFilterMemberTag(m map[string]interface{}) *types.LifecycleRuleFilterMemberTag {
+func expandLifecycleRuleFilterTag(m map[string]interface{}) *types.Tag {
 	if len(m) == 0 {
 		return nil
 	}
 
-	result := &types.LifecycleRuleFilterMemberTag{
-		Value: types.Tag{},
-	}
+	result := &types.Tag{}
 
 	if key, ok := m["key"].(string); ok {
-		result.Value.Key = aws.String(key)
+		result.Key = aws.String(key)
 	}
 
 	if value, ok := m["value"].(string); ok {
-		result.Value.Value = aws.String(value)
+		result.Value = aws.String(value)
 	}
 
 	return result
@@ -923,24 +919,24 @@ func flattenLifecycleExpiration(expiration *types.LifecycleExpiration) []interfa
 	return []interface{}{m}
 }
 
-func flattenLifecycleRuleFilter(ctx context.Context, filter types.LifecycleRuleFilter) []interface{} {
+func flattenLifecycleRuleFilter(ctx context.Context, filter *types.LifecycleRuleFilter) []interface{} {
 	if filter == nil {
 		return nil
 	}
 
 	m := make(map[string]interface{})
 
-	switch v := filter.(type) {
-	case *types.LifecycleRuleFilterMemberAnd:
-		m["and"] = flattenLifecycleRuleFilterMemberAnd(ctx, v)
-	case *types.LifecycleRuleFilterMemberObjectSizeGreaterThan:
-		m["object_size_greater_than"] = strconv.FormatInt(v.Value, 10)
-	case *types.LifecycleRuleFilterMemberObjectSizeLessThan:
-		m["object_size_less_than"] = strconv.FormatInt(v.Value, 10)
-	case *types.LifecycleRuleFilterMemberPrefix:
-		m["prefix"] = v.Value
-	case *types.LifecycleRuleFilterMemberTag:
-		m["tag"] = flattenLifecycleRuleFilterMemberTag(v)
+	switch {
+	case filter.And != nil:
+		m["and"] = flattenLifecycleRuleAndOperator(ctx, filter.And)
+	case filter.ObjectSizeGreaterThan != nil:
+		m["object_size_greater_than"] = strconv.FormatInt(aws.ToInt64(filter.ObjectSizeGreaterThan), 10)
+	case filter.ObjectSizeLessThan != nil:
+		m["object_size_less_than"] = strconv.FormatInt(aws.ToInt64(filter.ObjectSizeLessThan), 10)
+	case filter.Prefix != nil:
+		m["prefix"] = aws.ToString(filter.Prefix)
+	case filter.Tag != nil:
+		m["tag"] = flattenLifecycleRuleFilterTag(filter.Tag)
 	default:
 		return nil
 	}
@@ -948,39 +944,39 @@ func flattenLifecycleRuleFilter(ctx context.Context, filter types.LifecycleRuleF
 	return []interface{}{m}
 }
 
-func flattenLifecycleRuleFilterMemberAnd(ctx context.Context, andOp *types.LifecycleRuleFilterMemberAnd) []interface{} {
+func flattenLifecycleRuleAndOperator(ctx context.Context, andOp *types.LifecycleRuleAndOperator) []interface{} {
 	if andOp == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"object_size_greater_than": andOp.Value.ObjectSizeGreaterThan,
-		"object_size_less_than":    andOp.Value.ObjectSizeLessThan,
+		"object_size_greater_than": andOp.ObjectSizeGreaterThan,
+		"object_size_less_than":    andOp.ObjectSizeLessThan,
 	}
 
-	if v := andOp.Value.Prefix; v != nil {
+	if v := andOp.Prefix; v != nil {
 		m["prefix"] = aws.ToString(v)
 	}
 
-	if v := andOp.Value.Tags; v != nil {
+	if v := andOp.Tags; v != nil {
 		m["tags"] = keyValueTags(ctx, v).IgnoreAWS().Map()
 	}
 
 	return []interface{}{m}
 }
 
-func flattenLifecycleRuleFilterMemberTag(op *types.LifecycleRuleFilterMemberTag) []interface{} {
-	if op == nil {
+func flattenLifecycleRuleFilterTag(tag *types.Tag) []interface{} {
+	if tag == nil {
 		return nil
 	}
 
 	m := make(map[string]interface{})
 
-	if v := op.Value.Key; v != nil {
+	if v := tag.Key; v != nil {
 		m["key"] = aws.ToString(v)
 	}
 
-	if v := op.Value.Value; v != nil {
+	if v := tag.Value; v != nil {
 		m["value"] = aws.ToString(v)
 	}
 
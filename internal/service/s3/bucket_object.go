@@ -339,7 +339,7 @@ func resourceBucketObjectDelete(ctx context.Context, d *schema.ResourceData, met
 
 	var err error
 	if _, ok := d.GetOk("version_id"); ok {
-		_, err = deleteAllObjectVersions(ctx, conn, bucket, key, d.Get("force_destroy").(bool), false)
+		_, err = deleteAllObjectVersions(ctx, conn, bucket, key, d.Get("force_destroy").(bool), false, false, false)
 	} else {
 		err = deleteObjectVersion(ctx, conn, bucket, key, "", false)
 	}
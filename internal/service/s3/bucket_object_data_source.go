@@ -238,7 +238,7 @@ func dataSourceBucketObjectRead(ctx context.Context, d *schema.ResourceData, met
 		d.Set("body", buf.String())
 	}
 
-	tags, err := objectListTags(ctx, conn, bucket, key)
+	tags, err := objectListTags(ctx, conn, bucket, key, aws.ToString(out.VersionId))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "listing tags for S3 Bucket (%s) Object (%s): %s", bucket, key, err)
@@ -254,7 +254,7 @@ func resourceBucketObjectLockConfigurationDelete(ctx context.Context, d *schema.
 	return nil
 }
 
-func findObjectLockConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*types.ObjectLockConfiguration, error) {
+func findObjectLockConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, optFns ...func(*s3.Options)) (*types.ObjectLockConfiguration, error) {
 	input := &s3.GetObjectLockConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
@@ -262,7 +262,7 @@ func findObjectLockConfiguration(ctx context.Context, conn *s3.Client, bucket, e
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	output, err := conn.GetObjectLockConfiguration(ctx, input)
+	output, err := conn.GetObjectLockConfiguration(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeObjectLockConfigurationNotFoundError) {
 		return nil, &retry.NotFoundError{
@@ -169,12 +169,12 @@ func resourceBucketOwnershipControlsDelete(ctx context.Context, d *schema.Resour
 	return diags
 }
 
-func findOwnershipControls(ctx context.Context, conn *s3.Client, bucket string) (*types.OwnershipControls, error) {
+func findOwnershipControls(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) (*types.OwnershipControls, error) {
 	input := &s3.GetBucketOwnershipControlsInput{
 		Bucket: aws.String(bucket),
 	}
 
-	output, err := conn.GetBucketOwnershipControls(ctx, input)
+	output, err := conn.GetBucketOwnershipControls(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeOwnershipControlsNotFoundError) {
 		return nil, &retry.NotFoundError{
@@ -2583,6 +2583,34 @@ func TestBucketRegionalDomainName(t *testing.T) {
 	}
 }
 
+func TestBucketRegionFromLocationConstraint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		LocationConstraint types.BucketLocationConstraint
+		Expected           string
+	}{
+		{
+			LocationConstraint: "",
+			Expected:           names.USEast1RegionID,
+		},
+		{
+			LocationConstraint: types.BucketLocationConstraintEu,
+			Expected:           names.EUWest1RegionID,
+		},
+		{
+			LocationConstraint: types.BucketLocationConstraint(names.USWest2RegionID),
+			Expected:           names.USWest2RegionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfs3.BucketRegionFromLocationConstraint(testCase.LocationConstraint); got != testCase.Expected {
+			t.Errorf("BucketRegionFromLocationConstraint(%q) = %q, want %q", testCase.LocationConstraint, got, testCase.Expected)
+		}
+	}
+}
+
 func TestWebsiteEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -425,7 +425,7 @@ func findBucketWebsite(ctx context.Context, conn *s3.Client, bucket, expectedBuc
 	return output, nil
 }
 
-func findBucketLocation(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketLocationOutput, error) {
+func findBucketLocation(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
 	input := &s3.GetBucketLocationInput{
 		Bucket: aws.String(bucket),
 	}
@@ -433,7 +433,7 @@ func findBucketLocation(ctx context.Context, conn *s3.Client, bucket, expectedBu
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
-	output, err := conn.GetBucketLocation(ctx, input)
+	output, err := conn.GetBucketLocation(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		return nil, &retry.NotFoundError{
@@ -13,7 +13,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // emptyBucket empties the specified S3 general purpose bucket by deleting all object versions and delete markers.
@@ -22,14 +24,16 @@ import (
 // Returns the number of object versions and delete markers deleted.
 func emptyBucket(ctx context.Context, conn *s3.Client, bucket string, force bool) (int64, error) {
 	nObjects, err := forEachObjectVersionsPage(ctx, conn, bucket, func(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
-		return deletePageOfObjectVersions(ctx, conn, bucket, force, page)
+		return deletePageOfObjectVersions(ctx, conn, bucket, force, false, page)
 	})
 
 	if err != nil {
 		return nObjects, err
 	}
 
-	n, err := forEachObjectVersionsPage(ctx, conn, bucket, deletePageOfDeleteMarkers)
+	n, err := forEachObjectVersionsPage(ctx, conn, bucket, func(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
+		return deletePageOfDeleteMarkers(ctx, conn, bucket, page)
+	})
 	nObjects += n
 
 	return nObjects, err
@@ -41,6 +45,78 @@ func emptyDirectoryBucket(ctx context.Context, conn *s3.Client, bucket string) (
 	return forEachObjectsPage(ctx, conn, bucket, deletePageOfObjects)
 }
 
+// abortMultipartUploads aborts all in-progress multipart uploads in the specified S3 bucket.
+// In-progress multipart uploads prevent an S3 directory bucket from being deleted.
+// Returns the number of multipart uploads aborted.
+func abortMultipartUploads(ctx context.Context, conn *s3.Client, bucket string) (int64, error) {
+	return abortMultipartUploadsWithPrefix(ctx, conn, bucket, "", func(string) bool { return true })
+}
+
+// abortObjectMultipartUploads aborts all in-progress multipart uploads of the specified S3 object, e.g. left by interrupted uploads.
+// Returns the number of multipart uploads aborted.
+func abortObjectMultipartUploads(ctx context.Context, conn *s3.Client, bucket, key string, optFns ...func(*s3.Options)) (int64, error) {
+	// Uploads are listed by key prefix, which also matches the uploads of other objects whose keys start with the object's key.
+	return abortMultipartUploadsWithPrefix(ctx, conn, bucket, key, func(v string) bool { return v == key }, optFns...)
+}
+
+// abortMultipartUploadsWithPrefix aborts the in-progress multipart uploads in the specified S3 bucket whose keys have the specified prefix and match the specified filter.
+func abortMultipartUploadsWithPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string, filter func(key string) bool, optFns ...func(*s3.Options)) (int64, error) {
+	var nUploads int64
+
+	input := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	var errs []error
+
+	pages := s3.NewListMultipartUploadsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			break
+		}
+
+		if err != nil {
+			return nUploads, fmt.Errorf("listing S3 bucket (%s) multipart uploads: %w", bucket, err)
+		}
+
+		for _, v := range page.Uploads {
+			key := aws.ToString(v.Key)
+			if !filter(key) {
+				continue
+			}
+
+			input := &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(bucket),
+				Key:      v.Key,
+				UploadId: v.UploadId,
+			}
+
+			_, err := conn.AbortMultipartUpload(ctx, input, optFns...)
+
+			if tfawserr.ErrCodeEquals(err, errCodeNoSuchUpload) {
+				continue
+			}
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("aborting S3 object (%s) multipart upload (%s): %w", key, aws.ToString(v.UploadId), err))
+				continue
+			}
+
+			nUploads++
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nUploads, fmt.Errorf("aborting S3 bucket (%s) multipart uploads: %w", bucket, err)
+	}
+
+	return nUploads, nil
+}
+
 // forEachObjectVersionsPage calls the specified function for each page returned from the S3 ListObjectVersionsPages API.
 func forEachObjectVersionsPage(ctx context.Context, conn *s3.Client, bucket string, fn func(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput) (int64, error)) (int64, error) {
 	var nObjects int64
@@ -110,8 +186,9 @@ func forEachObjectsPage(ctx context.Context, conn *s3.Client, bucket string, fn
 // deletePageOfObjectVersions deletes a page (<= 1000) of S3 object versions.
 // If `force` is `true` then S3 Object Lock governance mode restrictions are bypassed and
 // an attempt is made to remove any S3 Object Lock legal holds.
+// If `bypassGovernanceRetention` is `true` then only S3 Object Lock governance mode restrictions are bypassed.
 // Returns the number of objects deleted.
-func deletePageOfObjectVersions(ctx context.Context, conn *s3.Client, bucket string, force bool, page *s3.ListObjectVersionsOutput) (int64, error) {
+func deletePageOfObjectVersions(ctx context.Context, conn *s3.Client, bucket string, force, bypassGovernanceRetention bool, page *s3.ListObjectVersionsOutput, optFns ...func(*s3.Options)) (int64, error) {
 	toDelete := tfslices.ApplyToAll(page.Versions, func(v types.ObjectVersion) types.ObjectIdentifier {
 		return types.ObjectIdentifier{
 			Key:       v.Key,
@@ -131,11 +208,11 @@ func deletePageOfObjectVersions(ctx context.Context, conn *s3.Client, bucket str
 			Quiet:   aws.Bool(true), // Only report errors.
 		},
 	}
-	if force {
-		input.BypassGovernanceRetention = aws.Bool(force)
+	if force || bypassGovernanceRetention {
+		input.BypassGovernanceRetention = aws.Bool(true)
 	}
 
-	output, err := conn.DeleteObjects(ctx, input)
+	output, err := conn.DeleteObjects(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		return nObjects, nil
@@ -159,26 +236,18 @@ func deletePageOfObjectVersions(ctx context.Context, conn *s3.Client, bucket str
 			key := aws.ToString(v.Key)
 			versionID := aws.ToString(v.VersionId)
 
-			_, err := conn.PutObjectLegalHold(ctx, &s3.PutObjectLegalHoldInput{
-				Bucket:    aws.String(bucket),
-				Key:       aws.String(key),
-				VersionId: aws.String(versionID),
-				LegalHold: &types.ObjectLockLegalHold{
-					Status: types.ObjectLockLegalHoldStatusOff,
-				},
-			})
-
-			if err != nil {
+			if err := removeObjectLegalHold(ctx, conn, bucket, key, versionID, optFns...); err != nil {
 				// Add the original error and the new error.
 				errs = append(errs, newDeleteObjectVersionError(v))
-				errs = append(errs, fmt.Errorf("removing legal hold: %w", newObjectVersionError(key, versionID, err)))
+				errs = append(errs, err)
 			} else {
 				// Attempt to delete the object once the legal hold has been removed.
 				_, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{
-					Bucket:    aws.String(bucket),
-					Key:       aws.String(key),
-					VersionId: aws.String(versionID),
-				})
+					Bucket:                    aws.String(bucket),
+					BypassGovernanceRetention: aws.Bool(force || bypassGovernanceRetention),
+					Key:                       aws.String(key),
+					VersionId:                 aws.String(versionID),
+				}, optFns...)
 
 				if err != nil {
 					errs = append(errs, fmt.Errorf("deleting: %w", newObjectVersionError(key, versionID, err)))
@@ -198,9 +267,49 @@ func deletePageOfObjectVersions(ctx context.Context, conn *s3.Client, bucket str
 	return nObjects, nil
 }
 
+// removePageOfObjectVersionsLegalHolds turns off any S3 Object Lock legal holds on a page (<= 1000) of S3 object versions.
+// Removing legal holds up front allows the object versions to be deleted in a single batch.
+// Returns the number of legal holds removed.
+func removePageOfObjectVersionsLegalHolds(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
+	var nObjects int64
+	var errs []error
+
+	for _, v := range page.Versions {
+		key, versionID := aws.ToString(v.Key), aws.ToString(v.VersionId)
+
+		output, err := findObjectVersion(ctx, conn, bucket, key, versionID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reading: %w", newObjectVersionError(key, versionID, err)))
+			continue
+		}
+
+		if output.ObjectLockLegalHoldStatus != types.ObjectLockLegalHoldStatusOn {
+			continue
+		}
+
+		if err := removeObjectLegalHold(ctx, conn, bucket, key, versionID); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		nObjects++
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nObjects, fmt.Errorf("removing S3 bucket (%s) object version legal holds: %w", bucket, err)
+	}
+
+	return nObjects, nil
+}
+
 // deletePageOfDeleteMarkers deletes a page (<= 1000) of S3 object delete markers.
 // Returns the number of delete markers deleted.
-func deletePageOfDeleteMarkers(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput) (int64, error) {
+func deletePageOfDeleteMarkers(ctx context.Context, conn *s3.Client, bucket string, page *s3.ListObjectVersionsOutput, optFns ...func(*s3.Options)) (int64, error) {
 	toDelete := tfslices.ApplyToAll(page.DeleteMarkers, func(v types.DeleteMarkerEntry) types.ObjectIdentifier {
 		return types.ObjectIdentifier{
 			Key:       v.Key,
@@ -221,7 +330,7 @@ func deletePageOfDeleteMarkers(ctx context.Context, conn *s3.Client, bucket stri
 		},
 	}
 
-	output, err := conn.DeleteObjects(ctx, input)
+	output, err := conn.DeleteObjects(ctx, input, optFns...)
 
 	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
 		return nObjects, nil
@@ -310,11 +419,13 @@ func newDeleteObjectVersionError(err types.Error) error {
 }
 
 // deleteAllObjectVersions deletes all versions of a specified key from an S3 general purpose bucket.
-// If key is empty then all versions of all objects are deleted.
+// Object versions and delete markers are deleted in batches (<= 1000) using the S3 DeleteObjects API.
 // Set `force` to `true` to override any S3 object lock protections on object lock enabled buckets.
+// Set `bypassGovernanceRetention` to `true` to override only S3 object lock governance mode retention.
+// Set `logVersions` to `true` to log the version ID of each object version and delete marker before its batch is deleted, as an audit trail.
 // Returns the number of objects deleted.
 // Use `emptyBucket` to delete all versions of all objects in a bucket.
-func deleteAllObjectVersions(ctx context.Context, conn *s3.Client, bucket, key string, force, ignoreObjectErrors bool, optFns ...func(*s3.Options)) (int64, error) {
+func deleteAllObjectVersions(ctx context.Context, conn *s3.Client, bucket, key string, force, bypassGovernanceRetention, ignoreObjectErrors, logVersions bool, optFns ...func(*s3.Options)) (int64, error) {
 	if key == "" {
 		return 0, errors.New("use `emptyBucket` to delete all versions of all objects in an S3 general purpose bucket")
 	}
@@ -338,74 +449,26 @@ func deleteAllObjectVersions(ctx context.Context, conn *s3.Client, bucket, key s
 			return nObjects, err
 		}
 
-		for _, objectVersion := range page.Versions {
-			objectKey := aws.ToString(objectVersion.Key)
-			objectVersionID := aws.ToString(objectVersion.VersionId)
-
-			if key != objectKey {
-				continue
-			}
-
-			err := deleteObjectVersion(ctx, conn, bucket, objectKey, objectVersionID, force, optFns...)
-
-			if err == nil {
-				nObjects++
+		// Ignore versions of other objects whose keys have the specified key as a prefix.
+		page.Versions = tfslices.Filter(page.Versions, func(v types.ObjectVersion) bool {
+			return aws.ToString(v.Key) == key
+		})
+
+		if logVersions {
+			for _, v := range page.Versions {
+				tflog.Info(ctx, "Deleting S3 Object version", map[string]any{
+					"bucket":     bucket,
+					"key":        key,
+					"version_id": aws.ToString(v.VersionId),
+				})
 			}
+		}
 
-			if tfawserr.ErrCodeEquals(err, errCodeAccessDenied) && force {
-				// Remove any legal hold.
-				input := &s3.HeadObjectInput{
-					Bucket:    aws.String(bucket),
-					Key:       aws.String(objectKey),
-					VersionId: aws.String(objectVersionID),
-				}
-
-				output, err := conn.HeadObject(ctx, input, optFns...)
-
-				if err != nil {
-					log.Printf("[ERROR] Getting S3 Bucket (%s) Object (%s) Version (%s) metadata: %s", bucket, objectKey, objectVersionID, err)
-					lastErr = err
-					continue
-				}
-
-				if output.ObjectLockLegalHoldStatus == types.ObjectLockLegalHoldStatusOn {
-					input := &s3.PutObjectLegalHoldInput{
-						Bucket: aws.String(bucket),
-						Key:    aws.String(objectKey),
-						LegalHold: &types.ObjectLockLegalHold{
-							Status: types.ObjectLockLegalHoldStatusOff,
-						},
-						VersionId: aws.String(objectVersionID),
-					}
-
-					_, err := conn.PutObjectLegalHold(ctx, input, optFns...)
-
-					if err != nil {
-						log.Printf("[ERROR] Putting S3 Bucket (%s) Object (%s) Version(%s) legal hold: %s", bucket, objectKey, objectVersionID, err)
-						lastErr = err
-						continue
-					}
-
-					// Attempt to delete again.
-					err = deleteObjectVersion(ctx, conn, bucket, objectKey, objectVersionID, force, optFns...)
-
-					if err != nil {
-						lastErr = err
-					} else {
-						nObjects++
-					}
-
-					continue
-				}
-
-				// AccessDenied for another reason.
-				lastErr = fmt.Errorf("deleting S3 Bucket (%s) Object (%s) Version (%s): %w", bucket, objectKey, objectVersionID, err)
-				continue
-			}
+		n, err := deletePageOfObjectVersions(ctx, conn, bucket, force, bypassGovernanceRetention, page, optFns...)
+		nObjects += n
 
-			if err != nil {
-				lastErr = err
-			}
+		if err != nil {
+			lastErr = err
 		}
 	}
 
@@ -429,22 +492,26 @@ func deleteAllObjectVersions(ctx context.Context, conn *s3.Client, bucket, key s
 			return nObjects, err
 		}
 
-		for _, deleteMarker := range page.DeleteMarkers {
-			deleteMarkerKey := aws.ToString(deleteMarker.Key)
-			deleteMarkerVersionID := aws.ToString(deleteMarker.VersionId)
+		page.DeleteMarkers = tfslices.Filter(page.DeleteMarkers, func(v types.DeleteMarkerEntry) bool {
+			return aws.ToString(v.Key) == key
+		})
 
-			if key != deleteMarkerKey {
-				continue
+		if logVersions {
+			for _, v := range page.DeleteMarkers {
+				tflog.Info(ctx, "Deleting S3 Object delete marker", map[string]any{
+					"bucket":     bucket,
+					"key":        key,
+					"version_id": aws.ToString(v.VersionId),
+				})
 			}
+		}
 
-			// Delete markers have no object lock protections.
-			err := deleteObjectVersion(ctx, conn, bucket, deleteMarkerKey, deleteMarkerVersionID, false, optFns...)
+		// Delete markers have no object lock protections.
+		n, err := deletePageOfDeleteMarkers(ctx, conn, bucket, page, optFns...)
+		nObjects += n
 
-			if err != nil {
-				lastErr = err
-			} else {
-				nObjects++
-			}
+		if err != nil {
+			lastErr = err
 		}
 	}
 
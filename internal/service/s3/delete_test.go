@@ -57,7 +57,7 @@ func TestDeleteAllObjectVersions(t *testing.T) {
 	}
 
 	client := s3.NewFromConfig(cfg)
-	n, err := tfs3.DeleteAllObjectVersions(ctx, client, *bucket, "", *force, false)
+	n, err := tfs3.DeleteAllObjectVersions(ctx, client, *bucket, "", *force, false, false, false)
 
 	if err != nil {
 		t.Fatalf("error emptying S3 bucket (%s): %s", *bucket, err)
@@ -26,7 +26,14 @@ const (
 func bucketNameTypeFor(bucket string) bucketNameType {
 	switch {
 	case arn.IsARN(bucket):
-		switch v, _ := arn.Parse(bucket); v.Resource {
+		v, _ := arn.Parse(bucket)
+		// e.g. accesspoint/example or accesspoint:example
+		resourceType := v.Resource
+		if i := strings.IndexAny(resourceType, "/:"); i >= 0 {
+			resourceType = resourceType[:i]
+		}
+
+		switch resourceType {
 		case "accesspoint":
 			switch v.Service {
 			case "s3":
@@ -46,5 +53,5 @@ func bucketNameTypeFor(bucket string) bucketNameType {
 		return bucketNameTypeObjectLambdaAccessPointAlias
 	}
 
-	return bucketNameTypeDirectoryBucket
+	return bucketNameTypeGeneralPurposeBucket
 }
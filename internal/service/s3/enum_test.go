@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"testing"
+)
+
+func TestBucketNameTypeFor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		bucket   string
+		expected bucketNameType
+	}{
+		{
+			name:     "general purpose bucket",
+			bucket:   "example",
+			expected: bucketNameTypeGeneralPurposeBucket,
+		},
+		{
+			name:     "general purpose bucket with dots",
+			bucket:   "example.com",
+			expected: bucketNameTypeGeneralPurposeBucket,
+		},
+		{
+			name:     "general purpose bucket with double dashes",
+			bucket:   "example--bucket",
+			expected: bucketNameTypeGeneralPurposeBucket,
+		},
+		{
+			name:     "unrecognized name",
+			bucket:   "Not_A-Valid.Bucket--Name",
+			expected: bucketNameTypeGeneralPurposeBucket,
+		},
+		{
+			name:     "unrecognized ARN",
+			bucket:   "arn:aws:s3:::example", //lintignore:AWSAT005
+			expected: bucketNameTypeGeneralPurposeBucket,
+		},
+		{
+			name:     "directory bucket",
+			bucket:   "example--usw2-az2--x-s3",
+			expected: bucketNameTypeDirectoryBucket,
+		},
+		{
+			name:     "access point alias",
+			bucket:   "example-ab1cdefghijklmnopqrstuvwxyz1234-s3alias",
+			expected: bucketNameTypeAccessPointAlias,
+		},
+		{
+			name:     "Object Lambda access point alias",
+			bucket:   "example-ab1cdefghijklmnopqrstuvwxyz1234--ol-s3",
+			expected: bucketNameTypeObjectLambdaAccessPointAlias,
+		},
+		{
+			name:     "access point ARN",
+			bucket:   "arn:aws:s3:us-west-2:123456789012:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			expected: bucketNameTypeAccessPointARN,
+		},
+		{
+			name:     "access point ARN with colon delimiter",
+			bucket:   "arn:aws:s3:us-west-2:123456789012:accesspoint:example", //lintignore:AWSAT003,AWSAT005
+			expected: bucketNameTypeAccessPointARN,
+		},
+		{
+			name:     "Object Lambda access point ARN",
+			bucket:   "arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/example", //lintignore:AWSAT003,AWSAT005
+			expected: bucketNameTypeObjectLambdaAccessPointARN,
+		},
+		{
+			name:     "Multi-Region Access Point ARN",
+			bucket:   "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap", //lintignore:AWSAT005
+			expected: bucketNameTypeMultiRegionAccessPointARN,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := bucketNameTypeFor(testCase.bucket), testCase.expected; got != want {
+				t.Errorf("bucketNameTypeFor(%q) = %v, want %v", testCase.bucket, got, want)
+			}
+		})
+	}
+}
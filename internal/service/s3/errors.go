@@ -11,10 +11,13 @@ import (
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3/#pkg-constants
 
 const (
+	errCodeAccessControlListNotSupported        = "AccessControlListNotSupported"
 	errCodeAccessDenied                         = "AccessDenied"
+	errCodeAuthorizationHeaderMalformed         = "AuthorizationHeaderMalformed"
 	errCodeBucketAlreadyExists                  = "BucketAlreadyExists"
 	errCodeBucketAlreadyOwnedByYou              = "BucketAlreadyOwnedByYou"
 	errCodeBucketNotEmpty                       = "BucketNotEmpty"
+	errCodeConditionalRequestConflict           = "ConditionalRequestConflict"
 	errCodeIllegalLocationConstraintException   = "IllegalLocationConstraintException"
 	errCodeInvalidArgument                      = "InvalidArgument"
 	errCodeInvalidBucketState                   = "InvalidBucketState"
@@ -26,9 +29,12 @@ const (
 	errCodeNoSuchConfiguration                  = "NoSuchConfiguration"
 	errCodeNoSuchCORSConfiguration              = "NoSuchCORSConfiguration"
 	errCodeNoSuchLifecycleConfiguration         = "NoSuchLifecycleConfiguration"
+	errCodeNoSuchObjectLockConfiguration        = "NoSuchObjectLockConfiguration"
 	errCodeNoSuchKey                            = "NoSuchKey"
 	errCodeNoSuchPublicAccessBlockConfiguration = "NoSuchPublicAccessBlockConfiguration"
 	errCodeNoSuchTagSet                         = "NoSuchTagSet"
+	errCodeNoSuchUpload                         = "NoSuchUpload"
+	errCodeNoSuchVersion                        = "NoSuchVersion"
 	errCodeNoSuchWebsiteConfiguration           = "NoSuchWebsiteConfiguration"
 	errCodeNotImplemented                       = "NotImplemented"
 	// errCodeObjectLockConfigurationNotFound should be used with tfawserr.ErrCodeContains, not tfawserr.ErrCodeEquals.
@@ -37,8 +43,11 @@ const (
 	errCodeObjectLockConfigurationNotFoundError      = "ObjectLockConfigurationNotFoundError"
 	errCodeOperationAborted                          = "OperationAborted"
 	errCodeOwnershipControlsNotFoundError            = "OwnershipControlsNotFoundError"
+	errCodePermanentRedirect                         = "PermanentRedirect"
 	errCodeReplicationConfigurationNotFound          = "ReplicationConfigurationNotFoundError"
+	errCodeRequestTimeout                            = "RequestTimeout"
 	errCodeServerSideEncryptionConfigurationNotFound = "ServerSideEncryptionConfigurationNotFoundError"
+	errCodeSlowDown                                  = "SlowDown"
 	errCodeUnsupportedArgument                       = "UnsupportedArgument"
 	// errCodeXNotImplemented is returned from third-party S3 API implementations.
 	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/14645.
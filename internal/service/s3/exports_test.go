@@ -27,13 +27,24 @@ var (
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
 	ResourceObjectCopy                              = resourceObjectCopy
+	ResourceObjectsUpload                           = resourceObjectsUpload
 
+	AbortMultipartUploads                 = abortMultipartUploads
+	AbortObjectMultipartUploads           = abortObjectMultipartUploads
 	BucketListTags                        = bucketListTags
-	BucketUpdateTags                      = bucketUpdateTags
+	BucketRegionFromLocationConstraint    = bucketRegionFromLocationConstraint
 	BucketRegionalDomainName              = bucketRegionalDomainName
+	BucketUpdateTags                      = bucketUpdateTags
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
+	CheckObjectComplianceRetention        = checkObjectComplianceRetention
+	CheckObjectKeyNormalization           = checkObjectKeyNormalization
+	ComputeObjectChecksums                = computeObjectChecksums
+	ComputeObjectMD5                      = computeObjectMD5
+	ComputeObjectSHA256                   = computeObjectSHA256
 	DeleteAllObjectVersions               = deleteAllObjectVersions
+	DetectObjectContentType               = detectObjectContentType
 	EmptyBucket                           = emptyBucket
+	ExpandObjectGrantees                  = expandObjectGrantees
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
 	FindBucket                            = findBucket
 	FindBucketACL                         = findBucketACL
@@ -51,16 +62,48 @@ var (
 	FindMetricsConfiguration              = findMetricsConfiguration
 	FindObjectByBucketAndKey              = findObjectByBucketAndKey
 	FindObjectLockConfiguration           = findObjectLockConfiguration
+	FindObjectVersion                     = findObjectVersion
+	FindObjectsUploadFiles                = findObjectsUploadFiles
 	FindOwnershipControls                 = findOwnershipControls
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
 	FindReplicationConfiguration          = findReplicationConfiguration
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
+	FlattenObjectExpiration               = flattenObjectExpiration
+	FlattenObjectGrantees                 = flattenObjectGrantees
+	HasObjectGrants                       = hasObjectGrants
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	IsKMSKeyARN                           = isKMSKeyARN
+	NewObjectUploadRetryer                = newObjectUploadRetryer
+	ObjectAccessDeniedError               = objectAccessDeniedError
+	ObjectAppendOffset                    = objectAppendOffset
+	ObjectClientOptFns                    = objectClientOptFns
+	ObjectIfNoneMatchError                = objectIfNoneMatchError
 	ObjectListTags                        = objectListTags
+	ObjectPartsCount                      = objectPartsCount
+	ObjectRetentionFromDefault            = objectRetentionFromDefault
+	ObjectStreamPartSize                  = objectStreamPartSize
+	ObjectUnversionedOverwriteWarning     = objectUnversionedOverwriteWarning
 	ObjectUpdateTags                      = objectUpdateTags
+	ParseObjectImportID                   = parseObjectImportID
+	ParseObjectTagsResourceID             = parseObjectTagsResourceID
+	ResolveObjectKey                      = resolveObjectKey
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
+	SuppressEquivalentObjectDate          = suppressEquivalentObjectDate
+	SuppressEquivalentObjectExpires       = suppressEquivalentObjectExpires
+	TagsFromKeyPattern                    = tagsFromKeyPattern
 	ValidBucketName                       = validBucketName
+	ValidateObjectACLOwnership            = validateObjectACLOwnership
+	ValidateObjectAccelerateBucket        = validateObjectAccelerateBucket
+	ValidateObjectBodySource              = validateObjectBodySource
+	ValidateObjectChecksumType            = validateObjectChecksumType
+	ValidateObjectContentUTF8             = validateObjectContentUTF8
+	ValidateObjectMetadataHTTPHeaders     = validateObjectMetadataHTTPHeaders
+	ValidateObjectRetainUntilDate         = validateObjectRetainUntilDate
+	ValidateObjectStorageClass            = validateObjectStorageClass
+	ValidateObjectTags                    = validateObjectTags
+	VerifyObjectChecksums                 = (*objectChecksums).verify
+	VerifyObjectETag                      = verifyObjectETag
 
 	BucketPropagationTimeout       = bucketPropagationTimeout
 	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
@@ -69,4 +112,8 @@ var (
 	ErrCodeNoSuchCORSConfiguration = errCodeNoSuchCORSConfiguration
 	LifecycleRuleStatusDisabled    = lifecycleRuleStatusDisabled
 	LifecycleRuleStatusEnabled     = lifecycleRuleStatusEnabled
+
+	ObjectCopySource = func(bucket, key, versionID string) string {
+		return objectCopySource{bucket: bucket, key: key, versionID: versionID}.copySource()
+	}
 )
@@ -6,21 +6,40 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"log"
+	"math"
+	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -31,6 +50,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -39,6 +59,23 @@ import (
 	"github.com/mitchellh/go-homedir"
 )
 
+// objectBypassGovernanceRetentionConfirmation is the value of bypass_governance_retention_confirmation that confirms GOVERNANCE mode retention is bypassed.
+const objectBypassGovernanceRetentionConfirmation = "bypass-governance-retention"
+
+// objectDefaultContentType is the content type of objects whose content type can't be detected.
+const objectDefaultContentType = "application/octet-stream"
+
+// Values of refresh_mode, which controls the API calls made to refresh an object's state.
+const (
+	objectRefreshModeFull     = "full"
+	objectRefreshModeHeadOnly = "head_only"
+	objectRefreshModeNone     = "none"
+)
+
+func objectRefreshMode_Values() []string {
+	return []string{objectRefreshModeFull, objectRefreshModeHeadOnly, objectRefreshModeNone}
+}
+
 // @SDKResource("aws_s3_object", name="Object")
 // @Tags(identifierAttribute="arn", resourceType="Object")
 func resourceObject() *schema.Resource {
@@ -52,6 +89,14 @@ func resourceObject() *schema.Resource {
 			StateContext: resourceObjectImport,
 		},
 
+		// The timeouts bound all API calls of an operation, including multipart uploads.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		CustomizeDiff: customdiff.Sequence(
 			resourceObjectCustomizeDiff,
 			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
@@ -60,30 +105,127 @@ func resourceObject() *schema.Resource {
 				}
 				return verify.SetTagsDiff(ctx, d, meta)
 			},
+			objectTagsCustomizeDiff,
+			objectVersionIDsCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
+			"access_control_policy": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"acl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grant": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"grantee": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"email_address": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"display_name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"type": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[types.Type](),
+												},
+												"uri": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"permission": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.Permission](),
+									},
+								},
+							},
+						},
+						"owner": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"acl": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
 				ValidateDiagFunc: enum.Validate[types.ObjectCannedACL](),
+				ConflictsWith:    []string{"access_control_policy"},
+			},
+			"append": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"body_updates_only_on_hash_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"bucket": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.NoZeroValues,
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.NoZeroValues,
+					validateObjectWriteBucket,
+				),
 			},
 			"bucket_key_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
 			},
+			"bucket_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bypass_governance_retention_confirmation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{objectBypassGovernanceRetentionConfirmation}, false),
+			},
 			"cache_control": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -101,6 +243,15 @@ func resourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"checksum_crc64nvme": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ChecksumMode](),
+			},
 			"checksum_sha1": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -109,15 +260,44 @@ func resourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"checksum_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.ChecksumType](),
+				DiffSuppressFunc: suppressObjectSinglePartChecksumType,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"source", "content_base64"},
+				ConflictsWith: []string{"source", "content_base64", "content_secret", "source_bucket"},
 			},
 			"content_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"source", "content"},
+				ConflictsWith: []string{"source", "content", "content_secret", "source_bucket"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// The configured value isn't stored in state, compare digests instead.
+					if !d.Get("content_base64_hash_only").(bool) || old != "" || new == "" || d.Id() == "" {
+						return false
+					}
+
+					v, err := contentBase64SHA256(new)
+					if err != nil {
+						return false
+					}
+
+					return v == d.Get("content_base64_sha256").(string)
+				},
+			},
+			"content_base64_hash_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"content_base64_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"content_disposition": {
 				Type:     schema.TypeString,
@@ -131,11 +311,53 @@ func resourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"content_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				RequiredWith: []string{"source"},
+			},
+			"content_secret": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"source", "content", "content_base64", "source_bucket"},
+			},
+			"content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content_type": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
+			"content_validate_utf8": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"delete_marker": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"delete_specific_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"detect_content_type": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"endpoint": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
+				ConflictsWith: []string{"use_accelerate_endpoint"},
+			},
 			"etag": {
 				Type: schema.TypeString,
 				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
@@ -144,12 +366,68 @@ func resourceObject() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"kms_key_id"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// The ETag isn't tracked, changes are detected using source_hash instead.
+					return !d.Get("manage_etag").(bool)
+				},
+			},
+			"expiration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiry_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"expires": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateObjectExpires,
+				DiffSuppressFunc: suppressEquivalentObjectExpires,
 			},
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"force_destroy_bypass_governance_retention": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_destroy_bypass_legal_hold": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_destroy_log_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"grant_full_control": objectGranteesSchema(),
+			"grant_read":         objectGranteesSchema(),
+			"grant_read_acp":     objectGranteesSchema(),
+			"grant_write_acp":    objectGranteesSchema(),
+			"if_match_on_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"if_none_match_etag": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"if_match_on_update"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
 			"key": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -160,7 +438,7 @@ func resourceObject() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: kms.ValidateKeyOrAlias,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					// ignore diffs where the user hasn't specified a kms_key_id but the bucket has a default KMS key configured
 					if new == "" && d.Get("server_side_encryption") == types.ServerSideEncryptionAwsKms {
@@ -169,11 +447,48 @@ func resourceObject() *schema.Resource {
 					return false
 				},
 			},
-			"metadata": {
-				Type:         schema.TypeMap,
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manage_etag": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"max_versions": {
+				Type:         schema.TypeInt,
 				Optional:     true,
-				Elem:         &schema.Schema{Type: schema.TypeString},
-				ValidateFunc: validateMetadataIsLowerCase,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"merge_existing_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validation.All(
+					validateMetadataIsLowerCase,
+					validateObjectMetadataHTTPHeaders,
+				),
+			},
+			"metadata_directive": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.MetadataDirective](),
+			},
+			"normalize_key": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"object_lock_inherited": {
+				Type:     schema.TypeBool,
+				Computed: true,
 			},
 			"object_lock_legal_hold_status": {
 				Type:             schema.TypeString,
@@ -183,12 +498,21 @@ func resourceObject() *schema.Resource {
 			"object_lock_mode": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				Computed:         true,
 				ValidateDiagFunc: enum.Validate[types.ObjectLockMode](),
+				RequiredWith:     []string{"object_lock_retain_until_date"},
 			},
 			"object_lock_retain_until_date": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentObjectDate,
+				RequiredWith:     []string{"object_lock_mode"},
+			},
+			"object_url": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"override_provider": {
 				Type:     schema.TypeList,
@@ -214,6 +538,26 @@ func resourceObject() *schema.Resource {
 					},
 				},
 			},
+			"parts_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"refresh_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      objectRefreshModeFull,
+				ValidateFunc: validation.StringInSlice(objectRefreshMode_Values(), false),
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"replication_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"server_side_encryption": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -221,26 +565,117 @@ func resourceObject() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
 			},
 			"source": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"content", "content_base64", "content_secret", "source_bucket"},
+				DiffSuppressFunc: suppressObjectSourcePathChange,
+			},
+			"source_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"content", "content_base64"},
+				ConflictsWith: []string{"content", "content_base64", "content_secret", "source"},
+				RequiredWith:  []string{"source_key"},
 			},
 			"source_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"source_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_bucket"},
+			},
+			"source_version_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_bucket"},
+			},
 			"storage_class": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
 				ValidateDiagFunc: enum.Validate[types.ObjectStorageClass](),
 			},
+			"tagging_directive": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.TaggingDirective](),
+				RequiredWith:     []string{"source_bucket"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tags_from_key_pattern": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"upload_retry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_delay": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"use_accelerate_endpoint": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"endpoint", "use_path_style"},
+			},
+			"use_path_style": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"use_accelerate_endpoint"},
+			},
+			"verify_checksum": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"verify_etag": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"version_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"website_redirect": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -251,11 +686,37 @@ func resourceObject() *schema.Resource {
 
 func resourceObjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := checkObjectWriteBucket(d.Get("bucket").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Object: %s", err)
+	}
+
+	if err := checkObjectRegion(ctx, meta, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Object: %s", err)
+	}
+
+	if err := checkObjectAccelerate(ctx, meta, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Object: %s", err)
+	}
+
+	if d.Get("normalize_key").(bool) {
+		diags = append(diags, checkObjectKeyNormalization(d.Get("key").(string))...)
+	}
+
 	return append(diags, resourceObjectUpload(ctx, d, meta)...)
 }
 
 func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+	return readObject(ctx, d, meta, d.Get("refresh_mode").(string))
+}
+
+// readObject reads the object's state, making the API calls required by the specified refresh mode.
+// The object is always fully read after it's created or updated, so that computed attributes are known.
+func readObject(ctx context.Context, d *schema.ResourceData, meta interface{}, refreshMode string) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	defer cancel()
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 	var optFns []func(*s3.Options)
 
@@ -263,12 +724,38 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if isDirectoryBucket(bucket) {
 		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
 	}
-	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
-	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
-		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	optFns = append(optFns, objectBucketARNOptFns(bucket)...)
+	optFns = append(optFns, objectClientOptFns(d)...)
+
+	return refreshObject(ctx, conn, d, meta, refreshMode, optFns...)
+}
+
+// refreshObject refreshes the object's state.
+// In "head_only" mode only HeadObject is called, so changes to the object's ACL aren't detected.
+// In "none" mode HeadObject is called only to check that the object exists, and the object's state is otherwise unchanged.
+// Whatever the mode, the object's tags are read.
+func refreshObject(ctx context.Context, conn *s3.Client, d *schema.ResourceData, meta interface{}, refreshMode string, optFns ...func(*s3.Options)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get("bucket").(string)
+	key := objectKey(d)
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	// Checksums are also retrieved for objects that weren't uploaded with a configured checksum_algorithm, e.g. imported objects or objects uploaded with the provider's default checksum algorithm.
+	if d.Get("checksum_algorithm").(string) != "" || types.ChecksumMode(d.Get("checksum_mode").(string)) == types.ChecksumModeEnabled || meta.(*conns.AWSClient).S3DefaultChecksumAlgorithm(ctx) != "" {
+		input.ChecksumMode = types.ChecksumModeEnabled
+	}
+
+	var output *s3.HeadObjectOutput
+	var err error
+	if d.IsNewResource() {
+		// A new object may not be immediately visible.
+		output, err = findObjectWithRetry(ctx, conn, input, d.Timeout(schema.TimeoutRead), optFns...)
+	} else {
+		output, err = findObject(ctx, conn, input, optFns...)
 	}
-	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
-	output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", d.Get("checksum_algorithm").(string), optFns...)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] S3 Object (%s) not found, removing from state", d.Id())
@@ -280,28 +767,69 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s): %s", d.Id(), err)
 	}
 
-	arn, err := newObjectARN(meta.(*conns.AWSClient).Partition, bucket, key)
+	if err := readObjectManagedTags(ctx, conn, d, bucket, key, optFns...); err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+
+	if refreshMode == objectRefreshModeNone {
+		return diags
+	}
+
+	arn, err := newObjectARN(meta.(*conns.AWSClient).Partition, bucket, key)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s): %s", d.Id(), err)
 	}
 	d.Set("arn", arn.String())
 
+	region := meta.(*conns.AWSClient).Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	d.Set("object_url", newObjectURL(bucket, key, region, d.Get("use_path_style").(bool) || conn.Options().UsePathStyle))
+
 	d.Set("bucket_key_enabled", output.BucketKeyEnabled)
 	d.Set("cache_control", output.CacheControl)
 	d.Set("checksum_crc32", output.ChecksumCRC32)
 	d.Set("checksum_crc32c", output.ChecksumCRC32C)
+	d.Set("checksum_crc64nvme", output.ChecksumCRC64NVME)
 	d.Set("checksum_sha1", output.ChecksumSHA1)
 	d.Set("checksum_sha256", output.ChecksumSHA256)
+	// The SHA-256 checksum of an object uploaded in a single part is the digest of its content, so it replaces the digest of the uploaded content
+	// to detect changes made outside of Terraform. The checksum of a multipart object ("<checksum>-<parts count>") is a checksum of its parts' checksums.
+	if _, ok := d.GetOk("source_bucket"); !ok && types.ChecksumAlgorithm(d.Get("checksum_algorithm").(string)) == types.ChecksumAlgorithmSha256 {
+		if v := aws.ToString(output.ChecksumSHA256); v != "" && !strings.Contains(v, "-") {
+			if hash, err := base64.StdEncoding.DecodeString(v); err == nil {
+				d.Set("content_base64_sha256", v)
+				d.Set("content_sha256", hex.EncodeToString(hash))
+			}
+		}
+	}
 	d.Set("content_disposition", output.ContentDisposition)
 	d.Set("content_encoding", output.ContentEncoding)
 	d.Set("content_language", output.ContentLanguage)
+	d.Set("content_length", output.ContentLength)
 	d.Set("content_type", output.ContentType)
+	d.Set("delete_marker", output.DeleteMarker)
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(aws.ToString(output.ETag), `"`))
+	if objectManagesETag(d) {
+		d.Set("etag", strings.Trim(aws.ToString(output.ETag), `"`))
+	} else {
+		// The ETag of KMS encrypted or multipart objects isn't an MD5 digest of the object content, so isn't tracked.
+		d.Set("etag", "")
+	}
+	if err := d.Set("expiration", flattenObjectExpiration(aws.ToString(output.Expiration))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting expiration: %s", err)
+	}
+	// S3 stores the Expires header as is, so it may not be a valid HTTP date, e.g. "0".
+	d.Set("expires", output.ExpiresString)
+	d.Set("last_modified", flattenObjectDate(output.LastModified))
 	d.Set("metadata", output.Metadata)
 	d.Set("object_lock_legal_hold_status", output.ObjectLockLegalHoldStatus)
 	d.Set("object_lock_mode", output.ObjectLockMode)
 	d.Set("object_lock_retain_until_date", flattenObjectDate(output.ObjectLockRetainUntilDate))
+	d.Set("parts_count", objectPartsCount(output))
+	// Empty unless the object is replicated, or is a replica.
+	d.Set("replication_status", output.ReplicationStatus)
 	d.Set("server_side_encryption", output.ServerSideEncryption)
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
@@ -312,16 +840,172 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("version_id", output.VersionId)
 	d.Set("website_redirect", output.WebsiteRedirectLocation)
 
-	if err := setObjectKMSKeyID(ctx, meta, d, aws.ToString(output.SSEKMSKeyId)); err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	if v := d.Get("content_base64").(string); v != "" {
+		hash, err := contentBase64SHA256(v)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		d.Set("content_base64_sha256", hash)
+
+		if d.Get("content_base64_hash_only").(bool) {
+			d.Set("content_base64", nil)
+		}
+	}
+
+	// The key is set even if it's the AWS managed key or the bucket's default key, so that it can be referenced.
+	d.Set("kms_key_id", output.SSEKMSKeyId)
+
+	if refreshMode == objectRefreshModeHeadOnly {
+		return diags
+	}
+
+	if input.ChecksumMode == types.ChecksumModeEnabled {
+		checksum, err := findObjectChecksum(ctx, conn, bucket, key, aws.ToString(output.VersionId), optFns...)
+
+		switch {
+		case err == nil:
+			d.Set("checksum_type", checksum.ChecksumType)
+		case tfresource.NotFound(err):
+			d.Set("checksum_type", nil)
+		case tfawserr.ErrCodeEquals(err, errCodeAccessDenied, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented):
+			// s3:GetObjectAttributes may not be granted, and some S3-compatible stores don't implement GetObjectAttributes.
+			log.Printf("[WARN] reading S3 Object (%s) checksum type: %s", d.Id(), err)
+		default:
+			return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s) checksum type: %s", d.Id(), err)
+		}
+	}
+
+	// The bucket's object lock configuration is only read for objects with retention, as it requires an additional permission (s3:GetBucketObjectLockConfiguration).
+	if output.ObjectLockMode != "" {
+		defaultRetention, err := findObjectBucketDefaultRetention(ctx, meta, d)
+
+		switch {
+		case tfawserr.ErrCodeEquals(err, errCodeAccessDenied, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented):
+			log.Printf("[WARN] reading S3 Bucket (%s) object lock configuration: %s", bucket, err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) object lock configuration: %s", bucket, err)
+		default:
+			d.Set("object_lock_inherited", objectRetentionFromDefault(output.ObjectLockMode, output.ObjectLockRetainUntilDate, output.LastModified, defaultRetention))
+		}
+	} else {
+		d.Set("object_lock_inherited", false)
+	}
+
+	if bucketRegion, err := findObjectBucketRegion(ctx, conn, meta, bucket, objectBucketClientOptFns(d)...); err != nil {
+		log.Printf("[WARN] reading S3 Bucket (%s) region: %s", bucket, err)
+	} else {
+		d.Set("bucket_region", bucketRegion)
+	}
+
+	// Only list the object's versions if configured, as they require an additional permission (s3:ListBucketVersions).
+	if maxVersions := d.Get("max_versions").(int); maxVersions > 0 && !isDirectoryBucket(bucket) {
+		versionIDs, err := findObjectVersionIDs(ctx, conn, bucket, key, maxVersions, optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing S3 Object (%s) versions: %s", d.Id(), err)
+		}
+
+		d.Set("version_ids", versionIDs)
+	} else {
+		d.Set("version_ids", nil)
+	}
+
+	// Only read explicit grants if configured, as they require an additional permission (s3:GetObjectAcl).
+	if hasObjectGrants(d) {
+		output, err := findObjectACL(ctx, conn, bucket, key, optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s) ACL: %s", d.Id(), err)
+		}
+
+		for _, k := range objectGrantAttributes() {
+			configured := flex.ExpandStringValueSet(d.Get(k).(*schema.Set))
+
+			// Grantees specified by email address are returned as canonical users, so their grants can't be compared with the configuration.
+			if slices.ContainsFunc(configured, func(v string) bool { return strings.HasPrefix(v, objectGranteeTypeEmailAddress+"=") }) {
+				continue
+			}
+
+			if err := d.Set(k, flattenObjectGrantees(output.Grants, output.Owner, objectGrantPermissions[k], configured)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("access_control_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		output, err := findObjectACL(ctx, conn, bucket, key, optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s) ACL: %s", d.Id(), err)
+		}
+
+		if err := d.Set("access_control_policy", flattenObjectACL(output)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting access_control_policy: %s", err)
+		}
 	}
 
 	return diags
 }
 
+// readObjectManagedTags sets the object's tags that are managed via `tags`, if only some of the object's tags are.
+// Otherwise all of the object's tags are listed by the transparent tagging interceptor, whatever the refresh mode.
+func readObjectManagedTags(ctx context.Context, conn *s3.Client, d *schema.ResourceData, bucket, key string, optFns ...func(*s3.Options)) error {
+	var filter func(tftags.KeyValueTags) tftags.KeyValueTags
+
+	if d.Get("merge_existing_tags").(bool) || types.TaggingDirective(d.Get("tagging_directive").(string)) == types.TaggingDirectiveCopy {
+		// Existing tags kept on upload, or tags copied from source_bucket, are not managed via `tags`.
+		filter = func(tags tftags.KeyValueTags) tftags.KeyValueTags {
+			return tags.Only(tftags.New(ctx, d.Get(names.AttrTagsAll)))
+		}
+	} else if v, ok := d.GetOk("tags_from_key_pattern"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		// Tags derived from the object's key are frozen at creation and are not managed via `tags`.
+		filter = func(tags tftags.KeyValueTags) tftags.KeyValueTags {
+			return tags.Ignore(tftags.New(ctx, v.([]interface{})[0].(map[string]interface{})["tags"]))
+		}
+	}
+
+	if filter == nil {
+		return nil
+	}
+
+	tags, err := objectListTags(ctx, conn, bucket, key, "", optFns...)
+
+	if err != nil {
+		return err
+	}
+
+	setTagsOut(ctx, Tags(filter(tags)))
+
+	return nil
+}
+
 func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	if hasObjectContentChanges(d) {
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if d.HasChange("region") {
+		if err := checkObjectRegion(ctx, meta, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Object (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("region", "use_accelerate_endpoint") {
+		if err := checkObjectAccelerate(ctx, meta, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Object (%s): %s", d.Id(), err)
+		}
+	}
+
+	// Body, metadata and storage class changes write a new object version, either uploaded or copied in place.
+	// Other changes, e.g. to tags, ACLs or retention, are applied to the current object version.
+	if hasObjectContentChanges(d) || d.HasChange("storage_class") {
+		// Overwriting an object in a bucket without versioning enabled loses its previous content, e.g. to a concurrent write.
+		if hasObjectBodyChanges(d) && !d.GetRawConfig().GetAttr("etag").IsNull() {
+			conn := meta.(*conns.AWSClient).S3Client(ctx)
+			diags = append(diags, objectUnversionedOverwriteWarning(ctx, conn, d.Get("bucket").(string), objectKey(d), objectBucketClientOptFns(d)...)...)
+		}
+
 		return append(diags, resourceObjectUpload(ctx, d, meta)...)
 	}
 
@@ -332,11 +1016,35 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	if isDirectoryBucket(bucket) {
 		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
 	}
-	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
-	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
-		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	optFns = append(optFns, objectBucketARNOptFns(bucket)...)
+	optFns = append(optFns, objectClientOptFns(d)...)
+	key := objectKey(d)
+
+	if d.HasChanges(objectGrantAttributes()...) {
+		input := &s3.PutObjectAclInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		if hasObjectGrants(d) {
+			input.GrantFullControl = expandObjectGrantees(d, "grant_full_control")
+			input.GrantRead = expandObjectGrantees(d, "grant_read")
+			input.GrantReadACP = expandObjectGrantees(d, "grant_read_acp")
+			input.GrantWriteACP = expandObjectGrantees(d, "grant_write_acp")
+		} else {
+			// Removing all grants resets the object's ACL to its canned ACL, which may be replaced by access_control_policy below.
+			input.ACL = types.ObjectCannedACLPrivate
+			if v, ok := d.GetOk("acl"); ok {
+				input.ACL = types.ObjectCannedACL(v.(string))
+			}
+		}
+
+		_, err := conn.PutObjectAcl(ctx, input, optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "putting S3 Object (%s) ACL: %s", d.Id(), objectACLError(objectAccessDeniedError(err, "s3:PutObjectAcl", d)))
+		}
 	}
-	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
 
 	if d.HasChange("acl") {
 		input := &s3.PutObjectAclInput{
@@ -348,7 +1056,23 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		_, err := conn.PutObjectAcl(ctx, input, optFns...)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "putting S3 Object (%s) ACL: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "putting S3 Object (%s) ACL: %s", d.Id(), objectACLError(objectAccessDeniedError(err, "s3:PutObjectAcl", d)))
+		}
+	}
+
+	if d.HasChange("access_control_policy") {
+		if v, ok := d.GetOk("access_control_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &s3.PutObjectAclInput{
+				AccessControlPolicy: expandAccessControlPolicy(v.([]interface{})),
+				Bucket:              aws.String(bucket),
+				Key:                 aws.String(key),
+			}
+
+			_, err := conn.PutObjectAcl(ctx, input, optFns...)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "putting S3 Object (%s) ACL: %s", d.Id(), objectACLError(objectAccessDeniedError(err, "s3:PutObjectAcl", d)))
+			}
 		}
 	}
 
@@ -364,7 +1088,7 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		_, err := conn.PutObjectLegalHold(ctx, input, optFns...)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "putting S3 Object (%s) legal hold: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "putting S3 Object (%s) legal hold: %s", d.Id(), objectAccessDeniedError(err, "s3:PutObjectLegalHold", d))
 		}
 	}
 
@@ -391,15 +1115,17 @@ func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		_, err := conn.PutObjectRetention(ctx, input, optFns...)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "putting S3 Object (%s) retention: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "putting S3 Object (%s) retention: %s", d.Id(), objectAccessDeniedError(err, "s3:PutObjectRetention", d))
 		}
 	}
 
-	return append(diags, resourceObjectRead(ctx, d, meta)...)
+	return append(diags, readObject(ctx, d, meta, objectRefreshModeFull)...)
 }
 
 func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 	var optFns []func(*s3.Options)
 
@@ -407,286 +1133,2927 @@ func resourceObjectDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	if isDirectoryBucket(bucket) {
 		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
 	}
-	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
-	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
-		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	optFns = append(optFns, objectBucketARNOptFns(bucket)...)
+	optFns = append(optFns, objectClientOptFns(d)...)
+	key := objectKey(d)
+
+	if d.Get("force_destroy_bypass_legal_hold").(bool) && d.Get("object_lock_legal_hold_status").(string) == string(types.ObjectLockLegalHoldStatusOn) {
+		if err := removeObjectLegalHold(ctx, conn, bucket, key, d.Get("version_id").(string), optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+		}
+	}
+
+	// Incomplete multipart uploads of the object, e.g. left by interrupted uploads, are billed for their parts and can prevent the bucket from being deleted.
+	// Failing to abort them doesn't prevent the object from being deleted.
+	if d.Get("force_destroy").(bool) {
+		if _, err := abortObjectMultipartUploads(ctx, conn, bucket, key, optFns...); err != nil {
+			diags = sdkdiag.AppendWarningf(diags, "deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+		}
 	}
-	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
 
 	var err error
-	if _, ok := d.GetOk("version_id"); ok {
-		_, err = deleteAllObjectVersions(ctx, conn, bucket, key, d.Get("force_destroy").(bool), false, optFns...)
+	if v, ok := d.GetOk("version_id"); ok {
+		if err := checkObjectComplianceRetention(d.Get("object_lock_mode").(string), d.Get("object_lock_retain_until_date").(string), time.Now()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s) version (%s): %s", bucket, key, v, err)
+		}
+
+		// Only the object version written by Terraform is deleted, without adding a delete marker.
+		// Any other versions of the object, e.g. written outside of Terraform since, are kept.
+		if d.Get("delete_specific_version").(bool) {
+			if err := deleteObjectVersion(ctx, conn, bucket, key, v.(string), objectBypassesGovernanceRetention(d), optFns...); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s) version (%s): %s", bucket, key, v, objectGovernanceRetentionError(err, d))
+			}
+
+			if _, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", "", optFns...); err == nil {
+				diags = sdkdiag.AppendWarningf(diags, "S3 Bucket (%s) Object (%s) version (%s) deleted, but the object still exists as it has other versions. With delete_specific_version, only the version written by Terraform is deleted.", bucket, key, v)
+			}
+
+			return diags
+		}
+
+		_, err = deleteAllObjectVersions(ctx, conn, bucket, key, d.Get("force_destroy").(bool), objectBypassesGovernanceRetention(d), false, d.Get("force_destroy_log_versions").(bool), optFns...)
 	} else {
 		err = deleteObjectVersion(ctx, conn, bucket, key, "", false, optFns...)
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Bucket (%s) Object (%s): %s", bucket, key, objectGovernanceRetentionError(err, d))
 	}
 
 	return diags
 }
 
 func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	id := d.Id()
-	id = strings.TrimPrefix(id, "s3://")
-	parts := strings.Split(id, "/")
-
-	if len(parts) < 2 {
-		return []*schema.ResourceData{d}, fmt.Errorf("id %s should be in format <bucket>/<key> or s3://<bucket>/<key>", id)
+	bucket, key, err := parseObjectImportID(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{d}, err
 	}
 
-	bucket := parts[0]
-	key := strings.Join(parts[1:], "/")
-
 	d.SetId(key)
 	d.Set("bucket", bucket)
 	d.Set("key", key)
+	// Defaults aren't applied on import.
+	d.Set("append", false)
+	d.Set("body_updates_only_on_hash_change", false)
+	d.Set("content_base64_hash_only", false)
+	d.Set("content_validate_utf8", false)
+	d.Set("delete_specific_version", false)
+	d.Set("detect_content_type", false)
+	d.Set("force_destroy_bypass_governance_retention", false)
+	d.Set("force_destroy_bypass_legal_hold", false)
+	d.Set("force_destroy_log_versions", false)
+	d.Set("manage_etag", true)
+	d.Set("max_versions", 0)
+	d.Set("merge_existing_tags", false)
+	d.Set("refresh_mode", objectRefreshModeFull)
+	d.Set("use_accelerate_endpoint", false)
+	d.Set("use_path_style", false)
+	d.Set("verify_checksum", false)
+	d.Set("verify_etag", false)
+
+	// An object in a bucket in another region is imported with the bucket's region, so that it's read using an S3 endpoint in that region.
+	if !arn.IsARN(bucket) && !isDirectoryBucket(bucket) {
+		if region, err := findObjectBucketRegion(ctx, meta.(*conns.AWSClient).S3Client(ctx), meta, bucket); err != nil {
+			log.Printf("[WARN] reading S3 Bucket (%s) region: %s", bucket, err)
+		} else if region != meta.(*conns.AWSClient).Region {
+			d.Set("region", region)
+		}
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).S3Client(ctx)
-	var optFns []func(*s3.Options)
+// parseObjectImportID returns the bucket and key of an object from an import ID in the format <bucket>/<key> or s3://<bucket>/<key>.
+// The key is kept as specified, including any trailing "/" of a directory placeholder object, e.g. "folder/".
+func parseObjectImportID(id string) (string, string, error) {
+	bucket, key, found := strings.Cut(strings.TrimPrefix(id, "s3://"), "/")
 
-	bucket := d.Get("bucket").(string)
-	if isDirectoryBucket(bucket) {
-		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
-	}
-	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
-	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
-		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	if !found || bucket == "" || sdkv1CompatibleCleanKey(key) == "" {
+		return "", "", fmt.Errorf("id %s should be in format <bucket>/<key> or s3://<bucket>/<key>", id)
 	}
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("source"); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "opening S3 object source (%s): %s", path, err)
-		}
+	return bucket, key, nil
+}
 
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk("content"); ok {
-		body = strings.NewReader(v.(string))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		v, err := itypes.Base64Decode(v.(string))
-		if err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
-		}
-		body = bytes.NewReader(v)
-	} else {
-		body = bytes.NewReader([]byte{})
+// checkObjectComplianceRetention returns an error if an object version is retained in COMPLIANCE mode at the specified time.
+// Unlike GOVERNANCE mode retention, COMPLIANCE mode retention can't be bypassed.
+func checkObjectComplianceRetention(mode, retainUntilDate string, now time.Time) error {
+	if mode != string(types.ObjectLockModeCompliance) {
+		return nil
 	}
 
-	input := &s3.PutObjectInput{
-		Body:   body,
-		Bucket: aws.String(bucket),
-		Key:    aws.String(sdkv1CompatibleCleanKey(d.Get("key").(string))),
+	if v := expandObjectDate(retainUntilDate); v != nil && v.After(now) {
+		return fmt.Errorf("object is retained in %s mode until %s and cannot be deleted before then, %s mode retention can't be bypassed or shortened", mode, retainUntilDate, mode)
 	}
 
-	if v, ok := d.GetOk("acl"); ok {
-		input.ACL = types.ObjectCannedACL(v.(string))
-	}
+	return nil
+}
 
-	if v, ok := d.GetOk("bucket_key_enabled"); ok {
-		input.BucketKeyEnabled = aws.Bool(v.(bool))
-	}
+// objectRetainUntilDateClockSkew is how far in the past a new retain-until date may be, as the local clock may be ahead of S3's.
+const objectRetainUntilDateClockSkew = 5 * time.Minute
 
-	if v, ok := d.GetOk("cache_control"); ok {
-		input.CacheControl = aws.String(v.(string))
+// validateObjectRetainUntilDate returns an error if the specified retain-until date is in the past at the specified time.
+// S3 rejects retention that has already expired with an obscure InvalidArgument error.
+func validateObjectRetainUntilDate(retainUntilDate string, now time.Time) error {
+	v := expandObjectDate(retainUntilDate)
+	if v == nil {
+		return nil
 	}
 
-	if v, ok := d.GetOk("checksum_algorithm"); ok {
-		input.ChecksumAlgorithm = types.ChecksumAlgorithm(v.(string))
+	if v.Before(now.Add(-objectRetainUntilDateClockSkew)) {
+		return fmt.Errorf("object_lock_retain_until_date (%s) is in the past (current time is %s), object retention can only be set to a future date", retainUntilDate, now.UTC().Format(time.RFC3339))
 	}
 
-	if v, ok := d.GetOk("content_disposition"); ok {
-		input.ContentDisposition = aws.String(v.(string))
+	return nil
+}
+
+// objectBypassesGovernanceRetention returns whether GOVERNANCE mode retention is bypassed when an object is deleted.
+// An object is deleted with the arguments in state, so bypass_governance_retention_confirmation must have been applied before the plan that deletes the object.
+func objectBypassesGovernanceRetention(d *schema.ResourceData) bool {
+	return d.Get("force_destroy_bypass_governance_retention").(bool) || d.Get("bypass_governance_retention_confirmation").(string) == objectBypassGovernanceRetentionConfirmation
+}
+
+// objectGovernanceRetentionError explains an access denied error deleting an object retained in GOVERNANCE mode.
+func objectGovernanceRetentionError(err error, d *schema.ResourceData) error {
+	if d.Get("object_lock_mode").(string) != string(types.ObjectLockRetentionModeGovernance) {
+		return err
 	}
 
-	if v, ok := d.GetOk("content_encoding"); ok {
-		input.ContentEncoding = aws.String(v.(string))
+	// Errors deleting object versions in batches are returned as "AccessDenied: <message>".
+	if !tfawserr.ErrCodeEquals(err, errCodeAccessDenied) && !strings.Contains(err.Error(), errCodeAccessDenied+":") {
+		return err
 	}
 
-	if v, ok := d.GetOk("content_language"); ok {
-		input.ContentLanguage = aws.String(v.(string))
+	if objectBypassesGovernanceRetention(d) {
+		return fmt.Errorf("bypassing GOVERNANCE mode retention requires the s3:BypassGovernanceRetention permission in addition to s3:DeleteObjectVersion: %w", err)
 	}
 
-	if v, ok := d.GetOk("content_type"); ok {
-		input.ContentType = aws.String(v.(string))
+	return fmt.Errorf("object is retained in GOVERNANCE mode until %s, to bypass its retention apply bypass_governance_retention_confirmation = %q before deleting the object, which requires the s3:BypassGovernanceRetention permission: %w", d.Get("object_lock_retain_until_date").(string), objectBypassGovernanceRetentionConfirmation, err)
+}
+
+// objectACLError adds guidance to the error returned when an object ACL is set in a bucket whose ACLs are disabled.
+func objectACLError(err error) error {
+	if tfawserr.ErrCodeEquals(err, errCodeAccessControlListNotSupported) {
+		return fmt.Errorf("the bucket's object ownership is BucketOwnerEnforced, which disables ACLs, remove acl and access_control_policy from the configuration or change the bucket's object ownership: %w", err)
 	}
 
-	if v, ok := d.GetOk("kms_key_id"); ok {
-		input.SSEKMSKeyId = aws.String(v.(string))
-		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	return err
+}
+
+// objectClientOptFns returns the S3 API client options configured on an object resource.
+func objectClientOptFns(d verify.ResourceDiffer) []func(*s3.Options) {
+	optFns := objectBucketClientOptFns(d)
+
+	if d.Get("use_accelerate_endpoint").(bool) {
+		optFns = append(optFns, func(o *s3.Options) { o.UseAccelerate = true })
 	}
 
-	if v, ok := d.GetOk("metadata"); ok {
-		input.Metadata = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	return optFns
+}
+
+// objectBucketARNOptFns returns the S3 API client options required for requests via the specified bucket, if it's an access point ARN.
+func objectBucketARNOptFns(bucket string) []func(*s3.Options) {
+	var optFns []func(*s3.Options)
+
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	// Requests are sent to the ARN's region, e.g. an opt-in region, whatever the provider's region.
+	if arn.IsARN(bucket) {
+		optFns = append(optFns, useARNRegion)
+	}
+	if accessPointTypeOf(bucket) == accessPointTypeMultiRegion {
+		optFns = append(optFns, useMultiRegionAccessPoint)
 	}
 
-	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
-		input.ObjectLockLegalHoldStatus = types.ObjectLockLegalHoldStatus(v.(string))
+	return optFns
+}
+
+// objectBucketClientOptFns returns the S3 API client options configured on an object resource that also apply to requests for its bucket's configuration.
+func objectBucketClientOptFns(d verify.ResourceDiffer) []func(*s3.Options) {
+	var optFns []func(*s3.Options)
+
+	if v, ok := d.GetOk("region"); ok {
+		optFns = append(optFns, func(o *s3.Options) { o.Region = v.(string) })
 	}
 
-	if v, ok := d.GetOk("object_lock_mode"); ok {
-		input.ObjectLockMode = types.ObjectLockMode(v.(string))
+	// Otherwise the provider's S3 endpoint configuration applies.
+	if v, ok := d.GetOk("endpoint"); ok {
+		optFns = append(optFns, func(o *s3.Options) { o.BaseEndpoint = aws.String(v.(string)) })
 	}
 
-	if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
-		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
+	// Otherwise the provider's s3_use_path_style configuration applies.
+	if d.Get("use_path_style").(bool) {
+		optFns = append(optFns, func(o *s3.Options) { o.UsePathStyle = true })
 	}
 
-	if v, ok := d.GetOk("server_side_encryption"); ok {
-		input.ServerSideEncryption = types.ServerSideEncryption(v.(string))
+	return optFns
+}
+
+// checkObjectRegion returns an error if an object's configured region isn't the region of its bucket.
+func checkObjectRegion(ctx context.Context, meta interface{}, d *schema.ResourceData) error {
+	region := d.Get("region").(string)
+	if region == "" {
+		return nil
 	}
 
-	if v, ok := d.GetOk("storage_class"); ok {
-		input.StorageClass = types.StorageClass(v.(string))
+	bucket := d.Get("bucket").(string)
+	if arn.IsARN(bucket) {
+		return errors.New("region cannot be specified when bucket is an ARN, the ARN's region is used")
+	}
+	// Directory buckets don't support GetBucketLocation.
+	if isDirectoryBucket(bucket) {
+		return nil
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := tftags.New(ctx, getContextTags(ctx))
-	if ignoreProviderDefaultTags(ctx, d) {
-		tags = tags.RemoveDefaultConfig(defaultTagsConfig)
-	} else {
-		tags = defaultTagsConfig.MergeTags(tftags.New(ctx, tags))
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	output, err := findBucketLocation(ctx, conn, bucket, "", objectBucketClientOptFns(d)...)
+
+	if err != nil {
+		return fmt.Errorf("reading S3 Bucket (%s) location: %w", bucket, err)
 	}
 
-	if len(tags) > 0 {
-		// The tag-set must be encoded as URL Query parameters.
-		input.Tagging = aws.String(tags.IgnoreAWS().URLEncode())
+	if bucketRegion := bucketRegionFromLocationConstraint(output.LocationConstraint); bucketRegion != region {
+		return fmt.Errorf("S3 Bucket (%s) is in region %s, not %s", bucket, bucketRegion, region)
 	}
 
-	if v, ok := d.GetOk("website_redirect"); ok {
-		input.WebsiteRedirectLocation = aws.String(v.(string))
+	return nil
+}
+
+// checkObjectAccelerate returns an error if use_accelerate_endpoint is configured and Transfer Acceleration isn't enabled on an object's bucket.
+func checkObjectAccelerate(ctx context.Context, meta interface{}, d *schema.ResourceData) error {
+	if !d.Get("use_accelerate_endpoint").(bool) {
+		return nil
 	}
 
-	if (input.ObjectLockLegalHoldStatus != "" || input.ObjectLockMode != "" || input.ObjectLockRetainUntilDate != nil) && input.ChecksumAlgorithm == "" {
-		// "Content-MD5 OR x-amz-checksum- HTTP header is required for Put Object requests with Object Lock parameters".
-		// AWS SDK for Go v1 transparently added a Content-MD4 header.
-		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
+	bucket := d.Get("bucket").(string)
+	if err := validateObjectAccelerateBucket(bucket); err != nil {
+		return err
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	output, err := findBucketAccelerateConfiguration(ctx, conn, bucket, "", objectBucketClientOptFns(d)...)
 
-	if _, err := uploader.Upload(ctx, input); err != nil {
-		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+	if err != nil {
+		return fmt.Errorf("reading S3 Bucket (%s) accelerate configuration: %w", bucket, err)
 	}
 
-	if d.IsNewResource() {
-		d.SetId(d.Get("key").(string))
+	if output.Status != types.BucketAccelerateStatusEnabled {
+		return fmt.Errorf("use_accelerate_endpoint requires Transfer Acceleration to be enabled on S3 Bucket (%s)", bucket)
 	}
 
-	return append(diags, resourceObjectRead(ctx, d, meta)...)
+	return nil
 }
 
-func setObjectKMSKeyID(ctx context.Context, meta interface{}, d *schema.ResourceData, sseKMSKeyID string) error {
-	// Only set non-default KMS key ID (one that doesn't match default).
+var objectAccelerateBucketNameRegex = regexache.MustCompile(`^[0-9a-z][0-9a-z-]{1,61}[0-9a-z]$`)
+
+// validateObjectAccelerateBucket returns an error if the specified bucket can't be used with an S3 Transfer Acceleration endpoint.
+// The bucket name must be DNS-compliant and not contain periods.
+func validateObjectAccelerateBucket(bucket string) error {
+	if arn.IsARN(bucket) {
+		return errors.New("use_accelerate_endpoint cannot be specified when bucket is an ARN")
+	}
+
+	if isDirectoryBucket(bucket) {
+		return errors.New("use_accelerate_endpoint cannot be specified for directory buckets")
+	}
+
+	if !objectAccelerateBucketNameRegex.MatchString(bucket) {
+		return fmt.Errorf("use_accelerate_endpoint requires a DNS-compliant bucket name without periods, got %q", bucket)
+	}
+
+	return nil
+}
+
+// objectAccessDeniedError adds the likely causes to an AccessDenied error returned by the specified S3 action on an object.
+// The causes are inferred from the error message and the object's configuration.
+func objectAccessDeniedError(err error, action string, d *schema.ResourceData) error {
+	if !tfawserr.ErrCodeEquals(err, errCodeAccessDenied) {
+		return err
+	}
+
+	var causes []string
+
+	// AWS KMS errors are returned as S3 AccessDenied errors whose message names the KMS action, e.g. "kms:GenerateDataKey".
+	if tfawserr.ErrMessageContains(err, errCodeAccessDenied, "kms:") {
+		causes = append(causes, "the KMS key policy or the caller's IAM policy doesn't allow the KMS action in the error message")
+	} else if action == "s3:PutObject" && objectUsesKMS(d) {
+		key := "the bucket's default KMS key"
+		if v := d.Get("kms_key_id").(string); v != "" {
+			key = fmt.Sprintf("KMS key (%s)", v)
+		}
+		causes = append(causes, fmt.Sprintf("the policy of %s doesn't allow kms:GenerateDataKey and kms:Decrypt", key))
+	}
+
+	switch {
+	// e.g. "... with an explicit deny in a resource-based policy".
+	case tfawserr.ErrMessageContains(err, errCodeAccessDenied, "resource-based policy"):
+		causes = append(causes, fmt.Sprintf("the bucket policy denies %s", action))
+	// e.g. "... because no identity-based policy allows the s3:PutObject action".
+	case tfawserr.ErrMessageContains(err, errCodeAccessDenied, "identity-based policy"):
+		causes = append(causes, fmt.Sprintf("the caller's IAM policy doesn't allow %s", action))
+	default:
+		causes = append(causes, fmt.Sprintf("the caller's IAM policy doesn't allow %s or the bucket policy denies it", action))
+	}
+
+	if action == "s3:PutObject" {
+		if hasObjectGrants(d) {
+			causes = append(causes, "grant_* also require s3:PutObjectAcl")
+		} else if _, ok := d.GetOk("acl"); ok {
+			causes = append(causes, "acl also requires s3:PutObjectAcl")
+		}
+		if _, ok := d.GetOk("access_control_policy"); ok {
+			causes = append(causes, "access_control_policy also requires s3:PutObjectAcl")
+		}
+		if len(d.Get(names.AttrTagsAll).(map[string]interface{})) > 0 {
+			causes = append(causes, "tags also require s3:PutObjectTagging")
+		}
+		if _, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+			causes = append(causes, "object_lock_legal_hold_status also requires s3:PutObjectLegalHold")
+		}
+		if _, ok := d.GetOk("object_lock_mode"); ok {
+			causes = append(causes, "object_lock_mode also requires s3:PutObjectRetention")
+		}
+	}
+
+	return fmt.Errorf("access denied, likely causes: %s: %w", strings.Join(causes, "; "), err)
+}
+
+// objectRetentionInheritedFromBucket returns whether an object's retention mode is that of its bucket's default retention,
+// in which case the retention is assumed to have been inherited from the bucket rather than configured.
+func objectRetentionInheritedFromBucket(ctx context.Context, meta interface{}, d verify.ResourceDiffer, mode string) (bool, error) {
+	defaultRetention, err := findObjectBucketDefaultRetention(ctx, meta, d)
+
+	// The bucket's object lock configuration can't be read without s3:GetBucketObjectLockConfiguration or from some S3-compatible stores,
+	// in which case the retention isn't considered inherited.
+	if tfawserr.ErrCodeEquals(err, errCodeAccessDenied, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented) {
+		log.Printf("[WARN] reading S3 Bucket (%s) object lock configuration: %s", d.Get("bucket").(string), err)
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return defaultRetention != nil && string(defaultRetention.Mode) == mode, nil
+}
+
+// findObjectBucketDefaultRetention returns the default retention of the object's bucket, or nil if the bucket has none.
+func findObjectBucketDefaultRetention(ctx context.Context, meta interface{}, d verify.ResourceDiffer) (*types.DefaultRetention, error) {
+	bucket := d.Get("bucket").(string)
+
+	// Access points and directory buckets don't have an object lock configuration.
+	if arn.IsARN(bucket) || isDirectoryBucket(bucket) {
+		return nil, nil
+	}
+
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	output, err := findObjectLockConfiguration(ctx, conn, bucket, "", objectBucketClientOptFns(d)...)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Rule == nil {
+		return nil, nil
+	}
+
+	return output.Rule.DefaultRetention, nil
+}
+
+// objectDefaultRetentionTolerance is the tolerance when comparing an object's retain-until date with the date computed from its bucket's default retention.
+// S3 computes the date from the object's creation time, of which Last-Modified only has a precision of a second.
+const objectDefaultRetentionTolerance = 2 * time.Second
+
+// objectRetentionFromDefault returns whether an object's retention is the retention that the specified default retention applied when the object was created,
+// i.e. whether its mode is the default mode and it's retained for the default retention period from the time it was last modified.
+// Retention configured with the same mode but another retain-until date, e.g. explicitly or by a later change to the default retention, isn't inherited.
+func objectRetentionFromDefault(mode types.ObjectLockMode, retainUntilDate, lastModified *time.Time, defaultRetention *types.DefaultRetention) bool {
+	if defaultRetention == nil || mode == "" || retainUntilDate == nil || lastModified == nil {
+		return false
+	}
+
+	if string(mode) != string(defaultRetention.Mode) {
+		return false
+	}
+
+	var expected time.Time
+	switch {
+	case aws.ToInt32(defaultRetention.Days) > 0:
+		expected = lastModified.AddDate(0, 0, int(aws.ToInt32(defaultRetention.Days)))
+	case aws.ToInt32(defaultRetention.Years) > 0:
+		expected = lastModified.AddDate(int(aws.ToInt32(defaultRetention.Years)), 0, 0)
+	default:
+		return false
+	}
+
+	delta := retainUntilDate.Sub(expected)
+
+	return delta > -objectDefaultRetentionTolerance && delta < objectDefaultRetentionTolerance
+}
+
+// objectUsesKMS returns whether an object is encrypted with an AWS KMS key.
+func objectUsesKMS(d *schema.ResourceData) bool {
+	if d.Get("kms_key_id").(string) != "" {
+		return true
+	}
+
+	switch types.ServerSideEncryption(d.Get("server_side_encryption").(string)) {
+	case types.ServerSideEncryptionAwsKms, types.ServerSideEncryptionAwsKmsDsse:
+		return true
+	}
+
+	return false
+}
+
+// removeObjectLegalHold turns off the legal hold of the specified object version.
+func removeObjectLegalHold(ctx context.Context, conn *s3.Client, bucket, key, versionID string, optFns ...func(*s3.Options)) error {
+	input := &s3.PutObjectLegalHoldInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		LegalHold: &types.ObjectLockLegalHold{
+			Status: types.ObjectLockLegalHoldStatusOff,
+		},
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	_, err := conn.PutObjectLegalHold(ctx, input, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessDenied) {
+		return fmt.Errorf("removing legal hold, the s3:PutObjectLegalHold permission is required: %w", newObjectVersionError(key, versionID, err))
+	}
+
+	if err != nil {
+		return fmt.Errorf("removing legal hold: %w", newObjectVersionError(key, versionID, err))
+	}
+
+	return nil
+}
+
+func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	var optFns []func(*s3.Options)
+
+	bucket := d.Get("bucket").(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	optFns = append(optFns, objectBucketARNOptFns(bucket)...)
+	optFns = append(optFns, objectClientOptFns(d)...)
+	var retryConfig map[string]interface{}
+	if v, ok := d.GetOk("upload_retry"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		retryConfig = v.([]interface{})[0].(map[string]interface{})
+	}
+	optFns = append(optFns, func(o *s3.Options) { o.Retryer = newObjectUploadRetryer(o.Retryer, retryConfig) })
+
+	// With if_match_on_update, an update only writes the object if it hasn't changed since it was last read.
+	// Uploads send an If-Match header with PutObject or CompleteMultipartUpload, and in-place copies send an x-amz-copy-source-if-match
+	// header with CopyObject or UploadPartCopy, so that the write fails with a 412 Precondition Failed error if the object has changed.
+	var ifMatchETag string
+	var ifMatch *string
+	if !d.IsNewResource() && d.Get("if_match_on_update").(bool) {
+		if v, _ := d.GetChange("etag"); v.(string) != "" {
+			ifMatchETag = v.(string)
+			ifMatch = aws.String(`"` + ifMatchETag + `"`)
+		} else {
+			diags = sdkdiag.AppendWarningf(diags, "S3 Object (%s) ETag isn't tracked, so it's updated unconditionally", d.Id())
+		}
+	}
+
+	// With if_none_match_etag, the object is only uploaded if its current ETag doesn't match, or with "*", if it doesn't exist.
+	// Uploads send an If-None-Match header with PutObject or CompleteMultipartUpload, so that the write fails with a 412 Precondition Failed error
+	// if the ETag matches. In-place copies are unconditional.
+	ifNoneMatchETag := d.Get("if_none_match_etag").(string)
+	var ifNoneMatch *string
+	switch ifNoneMatchETag {
+	case "":
+	case "*":
+		ifNoneMatch = aws.String(ifNoneMatchETag)
+	default:
+		ifNoneMatch = aws.String(`"` + strings.Trim(ifNoneMatchETag, `"`) + `"`)
+	}
+
+	var body io.ReadSeeker
+	var stream io.Reader
+
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		// A change to source alone may be suppressed, see suppressObjectSourcePathChange, so the file is read from its configured path.
+		if v := d.GetRawConfig().GetAttr("source"); v.IsKnown() && !v.IsNull() {
+			source = v.AsString()
+		}
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "opening S3 object source (%s): %s", path, err)
+		}
+
+		// A named pipe, e.g. from process substitution, can't be seeked and is uploaded as a stream.
+		if isObjectSourceStream(path) {
+			if d.Get("verify_checksum").(bool) {
+				file.Close()
+				return sdkdiag.AppendErrorf(diags, "verify_checksum is not supported when source (%s) is a stream", path)
+			}
+			if d.Get("verify_etag").(bool) {
+				file.Close()
+				return sdkdiag.AppendErrorf(diags, "verify_etag is not supported when source (%s) is a stream", path)
+			}
+
+			stream = file
+		} else {
+			body = file
+		}
+		defer func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}()
+	} else if v := objectContent(d); v != "" {
+		// The content may not have been known at plan time.
+		if d.Get("content_validate_utf8").(bool) {
+			if err := validateObjectContentUTF8(v); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+
+		body = strings.NewReader(v)
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		v, err := itypes.Base64Decode(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		body = bytes.NewReader(v)
+	} else {
+		body = bytes.NewReader([]byte{})
+	}
+
+	input := &s3.PutObjectInput{
+		Body:   body,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(objectKey(d)),
+	}
+
+	// A canned ACL and grant headers can't both be sent. acl is computed, so it may be set in state when grants are configured.
+	if hasObjectGrants(d) {
+		input.GrantFullControl = expandObjectGrantees(d, "grant_full_control")
+		input.GrantRead = expandObjectGrantees(d, "grant_read")
+		input.GrantReadACP = expandObjectGrantees(d, "grant_read_acp")
+		input.GrantWriteACP = expandObjectGrantees(d, "grant_write_acp")
+	} else if v, ok := d.GetOk("acl"); ok {
+		input.ACL = types.ObjectCannedACL(v.(string))
+	}
+
+	// Only send bucket_key_enabled if configured, so that an unset value inherits the bucket's default encryption setting.
+	if v := d.GetRawConfig().GetAttr("bucket_key_enabled"); v.IsKnown() && !v.IsNull() {
+		input.BucketKeyEnabled = aws.Bool(v.True())
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	// The provider's default checksum algorithm isn't stored in state, so that objects uploaded without a checksum don't have a difference.
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumAlgorithm = types.ChecksumAlgorithm(v.(string))
+	} else if v := meta.(*conns.AWSClient).S3DefaultChecksumAlgorithm(ctx); v != "" {
+		input.ChecksumAlgorithm = types.ChecksumAlgorithm(v)
+	}
+
+	if v, ok := d.GetOk("content_disposition"); ok {
+		input.ContentDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_encoding"); ok {
+		input.ContentEncoding = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_language"); ok {
+		input.ContentLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_type"); ok {
+		input.ContentType = aws.String(v.(string))
+	} else if _, ok := d.GetOk("source_bucket"); !ok && d.Get("detect_content_type").(bool) {
+		// The source file didn't exist at plan time.
+		v, err := detectObjectContentType(d.Get("key").(string), d.Get("source").(string), objectContent(d), d.Get("content_base64").(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.ContentType = aws.String(v)
+	}
+
+	// Only send kms_key_id and server_side_encryption if configured, so that unset values inherit the bucket's default encryption.
+	if v := d.GetRawConfig().GetAttr("kms_key_id"); v.IsKnown() && !v.IsNull() {
+		input.SSEKMSKeyId = aws.String(v.AsString())
+		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		input.Metadata = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	// An object's legal hold and retention are written with the object itself, so that they apply from its first version
+	// without separate PutObjectLegalHold and PutObjectRetention requests.
+	if v, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		input.ObjectLockLegalHoldStatus = types.ObjectLockLegalHoldStatus(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_mode"); ok {
+		input.ObjectLockMode = types.ObjectLockMode(v.(string))
+	}
+
+	if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
+		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
+	}
+
+	if v := d.GetRawConfig().GetAttr("server_side_encryption"); v.IsKnown() && !v.IsNull() {
+		input.ServerSideEncryption = types.ServerSideEncryption(v.AsString())
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = types.StorageClass(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := tftags.New(ctx, getContextTags(ctx))
+	if ignoreProviderDefaultTags(ctx, d) {
+		// Removing the provider's default tags would also remove any resource tags with the same key and value.
+		tags = tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+	} else {
+		tags = defaultTagsConfig.MergeTags(tftags.New(ctx, tags))
+	}
+
+	// A new object version replaces all of the object's tags, so keep any tags that are ignored via the provider configuration.
+	if !d.IsNewResource() && ignoreTagsConfig != nil && len(ignoreTagsConfig.Keys)+len(ignoreTagsConfig.KeyPrefixes) > 0 {
+		allTags, err := objectListTags(ctx, conn, bucket, aws.ToString(input.Key), "", optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing tags for S3 Object (%s) in Bucket (%s): %s", aws.ToString(input.Key), bucket, err)
+		}
+
+		allTags = allTags.IgnoreAWS()
+		tags = tags.Merge(allTags.Ignore(allTags.IgnoreConfig(ignoreTagsConfig)))
+	}
+
+	if d.Get("merge_existing_tags").(bool) {
+		existingTags, err := objectListTags(ctx, conn, bucket, aws.ToString(input.Key), "", optFns...)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchKey) {
+			existingTags, err = tftags.New(ctx, nil), nil
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing tags for S3 Object (%s) in Bucket (%s): %s", aws.ToString(input.Key), bucket, err)
+		}
+
+		// Tags previously managed by Terraform are not kept, and configured tags take precedence over existing tags.
+		o, _ := d.GetChange(names.AttrTagsAll)
+		tags = existingTags.IgnoreAWS().Ignore(tftags.New(ctx, o)).Merge(tags)
+	}
+
+	if v, ok := d.GetOk("tags_from_key_pattern"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		keyTags, err := tagsFromKeyPattern(ctx, v.([]interface{})[0].(map[string]interface{}), aws.ToString(input.Key))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		tags = tags.Merge(keyTags)
+	}
+
+	if len(tags) > 0 {
+		// The tag-set must be encoded as URL Query parameters.
+		input.Tagging = aws.String(tags.IgnoreAWS().URLEncode())
+	}
+
+	if v, ok := d.GetOk("expires"); ok {
+		input.Expires, _ = expandObjectExpires(v.(string))
+	}
+
+	if v, ok := d.GetOk("website_redirect"); ok {
+		input.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+
+	if (input.ObjectLockLegalHoldStatus != "" || input.ObjectLockMode != "" || input.ObjectLockRetainUntilDate != nil) && input.ChecksumAlgorithm == "" {
+		// "Content-MD5 OR x-amz-checksum- HTTP header is required for Put Object requests with Object Lock parameters".
+		// AWS SDK for Go v1 transparently added a Content-MD4 header.
+		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
+	}
+
+	var checksums *objectChecksums
+	var output *manager.UploadOutput
+
+	if v, ok := d.GetOk("source_bucket"); ok {
+		source := objectCopySource{
+			bucket:    v.(string),
+			key:       sdkv1CompatibleCleanKey(d.Get("source_key").(string)),
+			versionID: d.Get("source_version_id").(string),
+		}
+
+		// The provider's default tags are merged with the source object's tags, so that all of the tags are applied by the copy.
+		taggingDirective := types.TaggingDirective(d.Get("tagging_directive").(string))
+		if taggingDirective == types.TaggingDirectiveCopy && len(tags) > 0 {
+			sourceTags, err := objectListTags(ctx, conn, source.bucket, source.key, source.versionID, optFns...)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for source S3 Object (%s): %s", source, err)
+			}
+
+			input.Tagging = aws.String(sourceTags.IgnoreAWS().Merge(tags).IgnoreAWS().URLEncode())
+			taggingDirective = types.TaggingDirectiveReplace
+		}
+
+		if err := copyObjectFrom(ctx, conn, input, source, types.MetadataDirective(d.Get("metadata_directive").(string)), taggingDirective, optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "copying S3 Object (%s) to S3 Object (%s) in Bucket (%s): %s", source, aws.ToString(input.Key), aws.ToString(input.Bucket), objectACLError(objectAccessDeniedError(err, "s3:PutObject", d)))
+		}
+
+		// The copied object's body doesn't pass through Terraform.
+		d.Set("content_base64_sha256", "")
+		d.Set("content_sha256", "")
+	} else if metadataDirective, ok := objectInPlaceCopyMetadataDirective(d); ok {
+		// The object's body hasn't changed, so the object is copied in place instead of uploading its body again.
+		// The object's current encryption is kept.
+		if input.ServerSideEncryption == "" {
+			input.ServerSideEncryption = types.ServerSideEncryption(d.Get("server_side_encryption").(string))
+			if input.ServerSideEncryption == types.ServerSideEncryptionAwsKms {
+				input.BucketKeyEnabled = aws.Bool(d.Get("bucket_key_enabled").(bool))
+				input.SSEKMSKeyId = aws.String(d.Get("kms_key_id").(string))
+			}
+		}
+
+		versionID, _ := d.GetChange("version_id")
+		source := objectCopySource{
+			bucket:    bucket,
+			key:       aws.ToString(input.Key),
+			versionID: versionID.(string),
+		}
+
+		input.IfMatch = ifMatch
+		if err := copyObjectFrom(ctx, conn, input, source, metadataDirective, types.TaggingDirectiveReplace, optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "copying S3 Object (%s) in Bucket (%s) in place: %s", aws.ToString(input.Key), aws.ToString(input.Bucket), objectIfMatchError(objectACLError(objectAccessDeniedError(err, "s3:PutObject", d)), ifMatchETag))
+		}
+	} else if stream != nil {
+		input.IfMatch = ifMatch
+		input.IfNoneMatch = ifNoneMatch
+		uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+
+		contentLength := int64(-1)
+		if v := d.GetRawConfig().GetAttr("content_length"); v.IsKnown() && !v.IsNull() {
+			contentLength, _ = v.AsBigFloat().Int64()
+		}
+
+		var contentSHA256 []byte
+		var err error
+		output, contentSHA256, err = uploadObjectStream(ctx, uploader, input, stream, contentLength)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), objectIfNoneMatchError(objectIfMatchError(objectACLError(objectAccessDeniedError(err, "s3:PutObject", d)), ifMatchETag), ifNoneMatchETag))
+		}
+
+		d.Set("content_base64_sha256", base64.StdEncoding.EncodeToString(contentSHA256))
+		d.Set("content_sha256", hex.EncodeToString(contentSHA256))
+		if objectManagesETag(d) {
+			d.Set("etag", strings.Trim(aws.ToString(output.ETag), `"`))
+		}
+	} else {
+		var uploadClient manager.UploadAPIClient = conn
+		if v := d.GetRawConfig().GetAttr("checksum_type"); v.IsKnown() && !v.IsNull() {
+			uploadClient = objectChecksumTypeUploadClient{
+				UploadAPIClient: conn,
+				checksumType:    types.ChecksumType(v.AsString()),
+			}
+		}
+		input.IfMatch = ifMatch
+		input.IfNoneMatch = ifNoneMatch
+		uploader := manager.NewUploader(uploadClient, manager.WithUploaderRequestOptions(optFns...))
+
+		if d.Get("verify_checksum").(bool) {
+			var err error
+			checksums, err = computeObjectChecksums(body, input.ChecksumAlgorithm, uploader.PartSize, uploader.MaxUploadParts)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "computing S3 Object (%s) %s checksum: %s", aws.ToString(input.Key), input.ChecksumAlgorithm, err)
+			}
+		}
+
+		var contentMD5 []byte
+		if d.Get("verify_etag").(bool) {
+			var err error
+			contentMD5, err = computeObjectMD5(body)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "computing S3 Object (%s) MD5 digest: %s", aws.ToString(input.Key), err)
+			}
+		}
+
+		contentSHA256, err := computeObjectSHA256(body)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "computing S3 Object (%s) SHA-256 digest: %s", aws.ToString(input.Key), err)
+		}
+
+		// The digest of the content may not have been known at plan time.
+		input.Key = aws.String(sdkv1CompatibleCleanKey(resolveObjectKey(d.Get("key").(string), hex.EncodeToString(contentSHA256))))
+
+		// With append, a body that starts with the object's current content only has the rest of its content written to the object.
+		// The write offset is the object's size in state, i.e. content_length as last read, and the prefix is checked against content_sha256.
+		var offset int64
+		var appending bool
+		if !d.IsNewResource() && d.Get("append").(bool) && !d.HasChanges(objectMetadataAttributes...) && !d.HasChange("checksum_algorithm") {
+			size, _ := d.GetChange("content_length")
+			digest, _ := d.GetChange("content_sha256")
+			offset, appending, err = objectAppendOffset(body, int64(size.(int)), digest.(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s) body: %s", aws.ToString(input.Key), err)
+			}
+		}
+
+		if appending {
+			var appendOutput *s3.PutObjectOutput
+			appendOutput, err = conn.PutObject(ctx, &s3.PutObjectInput{
+				Body:              body,
+				Bucket:            input.Bucket,
+				ChecksumAlgorithm: input.ChecksumAlgorithm,
+				IfMatch:           input.IfMatch,
+				IfNoneMatch:       input.IfNoneMatch,
+				Key:               input.Key,
+				WriteOffsetBytes:  aws.Int64(offset),
+			}, optFns...)
+
+			if err == nil {
+				output = &manager.UploadOutput{
+					ETag:      appendOutput.ETag,
+					VersionID: appendOutput.VersionId,
+				}
+			}
+		} else {
+			output, err = uploader.Upload(ctx, input)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), objectIfNoneMatchError(objectIfMatchError(objectACLError(objectAccessDeniedError(err, "s3:PutObject", d)), ifMatchETag), ifNoneMatchETag))
+		}
+
+		d.Set("content_base64_sha256", base64.StdEncoding.EncodeToString(contentSHA256))
+		d.Set("content_sha256", hex.EncodeToString(contentSHA256))
+		// The ETag of KMS encrypted or multipart objects isn't an MD5 digest of the object content, e.g. "<md5>-<parts count>", so it's kept as returned.
+		if objectManagesETag(d) {
+			d.Set("etag", strings.Trim(aws.ToString(output.ETag), `"`))
+		}
+
+		// A mismatch isn't an error, as the ETag may legitimately differ, e.g. for an object encrypted by an S3-compatible store.
+		if contentMD5 != nil {
+			if err := verifyObjectETag(output, contentMD5); err != nil {
+				diags = sdkdiag.AppendWarningf(diags, "verifying S3 Object (%s) in Bucket (%s) upload: %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+			}
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(resolveObjectKey(d.Get("key").(string), d.Get("content_sha256").(string)))
+	}
+
+	if checksums != nil {
+		if err := checksums.verify(output); err != nil {
+			return sdkdiag.AppendErrorf(diags, "verifying S3 Object (%s) in Bucket (%s) upload: %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+		}
+	}
+
+	// Explicit grants can't be specified on upload, and uploading a new object version resets its ACL.
+	if v, ok := d.GetOk("access_control_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &s3.PutObjectAclInput{
+			AccessControlPolicy: expandAccessControlPolicy(v.([]interface{})),
+			Bucket:              input.Bucket,
+			Key:                 input.Key,
+		}
+
+		if _, err := conn.PutObjectAcl(ctx, input, optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "putting S3 Object (%s) in Bucket (%s) ACL: %s", aws.ToString(input.Key), aws.ToString(input.Bucket), objectACLError(objectAccessDeniedError(err, "s3:PutObjectAcl", d)))
+		}
+	}
+
+	return append(diags, readObject(ctx, d, meta, objectRefreshModeFull)...)
+}
+
+// objectChecksumTypeUploadClient sets the checksum type of multipart uploads created by the S3 upload manager.
+// PutObject doesn't take a checksum type, and the upload manager doesn't set one on the multipart uploads it creates,
+// so the checksum type is only sent for multipart uploads.
+type objectChecksumTypeUploadClient struct {
+	manager.UploadAPIClient
+	checksumType types.ChecksumType
+}
+
+func (c objectChecksumTypeUploadClient) CreateMultipartUpload(ctx context.Context, input *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	input.ChecksumType = c.checksumType
+
+	return c.UploadAPIClient.CreateMultipartUpload(ctx, input, optFns...)
+}
+
+func setObjectKMSKeyID(ctx context.Context, meta interface{}, d *schema.ResourceData, sseKMSKeyID string) error {
+	// Only set non-default KMS key ID (one that doesn't match default).
 	if sseKMSKeyID != "" {
 		// Read S3 KMS default master key.
-		keyMetadata, err := kms.FindKeyByID(ctx, meta.(*conns.AWSClient).KMSConn(ctx), defaultKMSKeyAlias)
+		defaultKMSKeyARN, err := findObjectKMSKeyARN(ctx, meta, defaultKMSKeyAlias)
+
+		if err != nil {
+			return fmt.Errorf("reading default S3 KMS key (%s): %s", defaultKMSKeyAlias, err)
+		}
+
+		if sseKMSKeyID != defaultKMSKeyARN {
+			log.Printf("[DEBUG] S3 object is encrypted using a non-default KMS key: %s", sseKMSKeyID)
+			d.Set("kms_key_id", sseKMSKeyID)
+		}
+	}
+
+	return nil
+}
+
+// findObjectKMSKeyARN returns the ARN of the KMS key identified by the specified key ID, key ARN, alias name or alias ARN.
+func findObjectKMSKeyARN(ctx context.Context, meta interface{}, keyID string) (string, error) {
+	if isKMSKeyARN(keyID) {
+		return keyID, nil
+	}
+
+	keyMetadata, err := kms.FindKeyByID(ctx, meta.(*conns.AWSClient).KMSConn(ctx), keyID)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(keyMetadata.Arn), nil
+}
+
+// isKMSKeyARN returns whether the specified value is the ARN of a KMS key, as opposed to a key ID or an alias.
+func isKMSKeyARN(v string) bool {
+	parsedARN, err := arn.Parse(v)
+
+	return err == nil && parsedARN.Service == "kms" && strings.HasPrefix(parsedARN.Resource, "key/")
+}
+
+func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(map[string]interface{})
+
+	for k := range value {
+		if k != strings.ToLower(k) {
+			errors = append(errors, fmt.Errorf(
+				"Metadata must be lowercase only. Offending key: %q", k))
+		}
+	}
+	return
+}
+
+// objectMetadataHTTPHeaders maps the HTTP headers that S3 stores as system-defined metadata to the arguments that set them.
+// Headers without an argument map to "".
+var objectMetadataHTTPHeaders = map[string]string{
+	"cache-control":                       "cache_control",
+	"content-disposition":                 "content_disposition",
+	"content-encoding":                    "content_encoding",
+	"content-language":                    "content_language",
+	"content-length":                      "",
+	"content-md5":                         "",
+	"content-type":                        "content_type",
+	"expires":                             "expires",
+	"x-amz-object-lock-legal-hold":        "object_lock_legal_hold_status",
+	"x-amz-object-lock-mode":              "object_lock_mode",
+	"x-amz-object-lock-retain-until-date": "object_lock_retain_until_date",
+	"x-amz-server-side-encryption":        "server_side_encryption",
+	"x-amz-storage-class":                 "storage_class",
+	"x-amz-website-redirect-location":     "website_redirect",
+}
+
+// validateObjectMetadataHTTPHeaders rejects user-defined metadata keys that are system-defined HTTP headers.
+// S3 would otherwise store them as x-amz-meta-<key> or reject the request.
+func validateObjectMetadataHTTPHeaders(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(map[string]interface{})
+
+	for key := range value {
+		attr, ok := objectMetadataHTTPHeaders[strings.ToLower(key)]
+		switch {
+		case !ok:
+		case attr == "":
+			errors = append(errors, fmt.Errorf("%s key %q is a reserved HTTP header and cannot be specified", k, key))
+		default:
+			errors = append(errors, fmt.Errorf("%s key %q is a reserved HTTP header, use the %s argument instead", k, key, attr))
+		}
+	}
+
+	return
+}
+
+func resourceObjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// ConflictsWith doesn't detect conflicts with values that are unknown at plan time.
+	if err := validateObjectBodySource(d.GetRawConfig()); err != nil {
+		return err
+	}
+
+	if err := checkObjectBucketPartition(d.Get("bucket").(string), meta.(*conns.AWSClient).Partition); err != nil {
+		return err
+	}
+
+	if !d.Get("manage_etag").(bool) && !d.GetRawConfig().GetAttr("etag").IsNull() {
+		return errors.New("etag cannot be configured when manage_etag is false, use source_hash to detect changes instead")
+	}
+
+	// The key is replaced when it changes, so it's only checked when the object is created.
+	// CustomizeDiff can't return warning diagnostics, so the warning is logged during plan and returned by resourceObjectCreate.
+	if d.Id() == "" && d.Get("normalize_key").(bool) && d.NewValueKnown("key") {
+		for _, v := range checkObjectKeyNormalization(d.Get("key").(string)) {
+			if v.Severity == diag.Error {
+				return errors.New(v.Detail)
+			}
+
+			log.Printf("[WARN] %s: %s", v.Summary, v.Detail)
+		}
+	}
+
+	if strings.Contains(d.Get("key").(string), objectKeyContentSHA256Placeholder) {
+		// The digest of the content is computed as the body is uploaded through Terraform, before the key is resolved.
+		if _, ok := d.GetOk("source_bucket"); ok {
+			return fmt.Errorf("key cannot contain %s when copying from source_bucket", objectKeyContentSHA256Placeholder)
+		}
+		if v, ok := d.GetOk("source"); ok && isObjectSourceStream(v.(string)) {
+			return fmt.Errorf("key cannot contain %s when source is a stream", objectKeyContentSHA256Placeholder)
+		}
+
+		// The object's key changes with its content, so the object is replaced.
+		if d.Id() != "" {
+			for _, key := range []string{"content", "content_base64", "content_secret", "etag", "source", "source_hash"} {
+				if d.HasChange(key) {
+					if err := d.ForceNew(key); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	if d.Id() != "" && d.HasChanges("region", "use_path_style") {
+		if err := d.SetNewComputed("object_url"); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("use_accelerate_endpoint").(bool) && d.NewValueKnown("bucket") {
+		if err := validateObjectAccelerateBucket(d.Get("bucket").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("append").(bool) {
+		if d.NewValueKnown("bucket") && !isDirectoryBucket(d.Get("bucket").(string)) {
+			return errors.New("append is only supported for directory buckets")
+		}
+		if _, ok := d.GetOk("source_bucket"); ok {
+			return errors.New("append is not supported when copying from source_bucket")
+		}
+		if d.Get("verify_checksum").(bool) {
+			return errors.New("verify_checksum is not supported with append")
+		}
+		if d.Get("verify_etag").(bool) {
+			return errors.New("verify_etag is not supported with append")
+		}
+	}
+
+	// Directory buckets don't support website redirects, so the upload or the in-place copy that changes the object's metadata would fail.
+	if v, ok := d.GetOk("website_redirect"); ok && d.NewValueKnown("bucket") && isDirectoryBucket(d.Get("bucket").(string)) {
+		return fmt.Errorf("website_redirect (%s) is not supported for directory buckets", v)
+	}
+
+	// HeadObject returns the ARN of the KMS key, so a configured key ID or alias is compared with the key it resolves to.
+	if d.Id() != "" && d.HasChange("kms_key_id") && d.NewValueKnown("kms_key_id") {
+		if o, n := d.GetChange("kms_key_id"); o.(string) != "" && n.(string) != "" && !isKMSKeyARN(n.(string)) {
+			keyARN, err := findObjectKMSKeyARN(ctx, meta, n.(string))
+
+			switch {
+			case tfresource.NotFound(err):
+				// The alias may be created in this apply.
+			case err != nil:
+				return fmt.Errorf("reading KMS Key (%s): %w", n, err)
+			case keyARN == o.(string):
+				if err := d.Clear("kms_key_id"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("tags_from_key_pattern"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil && d.NewValueKnown("key") {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		if _, err := tagsFromKeyPattern(ctx, tfMap, sdkv1CompatibleCleanKey(d.Get("key").(string))); err != nil {
+			return err
+		}
+
+		for k := range tfMap["tags"].(map[string]interface{}) {
+			if _, ok := d.Get(names.AttrTags).(map[string]interface{})[k]; ok {
+				return fmt.Errorf("tag %q is configured in both tags and tags_from_key_pattern", k)
+			}
+		}
+	}
+
+	if d.Get("content_validate_utf8").(bool) && d.NewValueKnown("content") && d.NewValueKnown("content_secret") {
+		if err := validateObjectContentUTF8(objectContent(d)); err != nil {
+			return err
+		}
+	}
+
+	// The provider's partition isn't known when validating the schema.
+	if v := d.GetRawConfig().GetAttr("storage_class"); d.HasChange("storage_class") && v.IsKnown() && !v.IsNull() {
+		if partition := meta.(*conns.AWSClient).Partition; partition != "" {
+			if err := validateObjectStorageClass(v.AsString(), partition); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Only a changed date is checked, the configured date of an existing object's retention passes once it expires.
+	if d.HasChange("object_lock_retain_until_date") && d.NewValueKnown("object_lock_retain_until_date") {
+		if err := validateObjectRetainUntilDate(d.Get("object_lock_retain_until_date").(string), time.Now()); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("verify_checksum").(bool) && d.NewValueKnown("checksum_algorithm") && d.Get("checksum_algorithm").(string) == "" {
+		return errors.New("verify_checksum requires checksum_algorithm to be set")
+	}
+
+	if d.Get("tagging_directive").(string) == string(types.TaggingDirectiveCopy) && len(d.Get(names.AttrTags).(map[string]interface{})) > 0 {
+		return errors.New("tags cannot be configured when tagging_directive is COPY, the source object's tags are copied")
+	}
+
+	if _, ok := d.GetOk("source_bucket"); ok && d.Get("verify_checksum").(bool) {
+		return errors.New("verify_checksum is not supported when copying from source_bucket")
+	}
+
+	if d.Get("verify_checksum").(bool) && types.ChecksumAlgorithm(d.Get("checksum_algorithm").(string)) == types.ChecksumAlgorithmCrc64nvme {
+		return fmt.Errorf("verify_checksum is not supported with checksum_algorithm %s", types.ChecksumAlgorithmCrc64nvme)
+	}
+
+	if v := d.GetRawConfig().GetAttr("checksum_type"); v.IsKnown() && !v.IsNull() && d.NewValueKnown("checksum_algorithm") {
+		if err := validateObjectChecksumType(types.ChecksumAlgorithm(d.Get("checksum_algorithm").(string)), types.ChecksumType(v.AsString())); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("source_bucket"); ok && d.Get("verify_etag").(bool) {
+		return errors.New("verify_etag is not supported when copying from source_bucket")
+	}
+
+	// With COPY, an object copied in place keeps its current metadata.
+	if _, ok := d.GetOk("source_bucket"); !ok && d.Id() != "" && d.Get("metadata_directive").(string) == string(types.MetadataDirectiveCopy) {
+		if d.HasChanges(objectMetadataAttributes...) && !hasObjectBodyChanges(d) {
+			return fmt.Errorf("metadata_directive must be %s to change %s without changing the object's body", types.MetadataDirectiveReplace, strings.Join(objectMetadataAttributes, ", "))
+		}
+	}
+
+	// With a SHA-256 checksum, the digest of the object's content is that of the object in S3, see resourceObjectRead.
+	// A difference from the digest of the configured content means the object was changed outside of Terraform, or the source file has changed.
+	// With body_updates_only_on_hash_change, the body is only uploaded again when etag or source_hash changes.
+	if _, ok := d.GetOk("source_bucket"); !ok && d.Id() != "" && !hasObjectContentChanges(d) && !d.Get("body_updates_only_on_hash_change").(bool) && d.Get("checksum_algorithm").(string) == string(types.ChecksumAlgorithmSha256) {
+		hash, ok, err := objectContentSHA256(d)
+		if err == nil && !ok {
+			hash, ok, err = objectSourceSHA256(d)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if ok && base64.StdEncoding.EncodeToString(hash) != d.Get("content_base64_sha256").(string) {
+			if err := d.SetNew("content_base64_sha256", base64.StdEncoding.EncodeToString(hash)); err != nil {
+				return err
+			}
+			if err := d.SetNew("content_sha256", hex.EncodeToString(hash)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, ok := d.GetOk("source_bucket"); !ok && d.Get("detect_content_type").(bool) && d.GetRawConfig().GetAttr("content_type").IsNull() {
+		if d.Id() == "" || d.HasChange("detect_content_type") || hasObjectContentChanges(d) {
+			if v, ok, err := objectContentTypeFromDiff(d); err != nil {
+				return err
+			} else if ok {
+				if err := d.SetNew("content_type", v); err != nil {
+					return err
+				}
+			} else if err := d.SetNewComputed("content_type"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("acl") {
+		if _, n := d.GetChange("acl"); n.(string) != "" {
+			if err := d.SetNewComputed("access_control_policy"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Setting an ACL in a bucket whose ACLs are disabled otherwise fails during apply with an AccessControlListNotSupported error.
+	// Access points and directory buckets don't have ownership controls.
+	if bucket := d.Get("bucket").(string); d.HasChange("acl") && d.NewValueKnown("acl") && d.NewValueKnown("bucket") && !arn.IsARN(bucket) && !isDirectoryBucket(bucket) {
+		if acl := d.Get("acl").(string); acl != "" && acl != string(types.ObjectCannedACLBucketOwnerFullControl) {
+			conn := meta.(*conns.AWSClient).S3Client(ctx)
+			ownership, err := findObjectBucketOwnership(ctx, conn, bucket, objectBucketClientOptFns(d)...)
+
+			// The bucket may be created in this apply, or reading its ownership controls may not be permitted.
+			if err != nil {
+				log.Printf("[WARN] reading S3 Bucket (%s) ownership controls: %s", bucket, err)
+			} else if err := validateObjectACLOwnership(acl, ownership); err != nil {
+				return err
+			}
+		}
+	}
+
+	// With body_updates_only_on_hash_change, metadata changes alone don't upload the configured content.
+	uploadsBody := d.Id() == "" || hasObjectBodyChanges(d) || (hasObjectContentChanges(d) && !d.Get("body_updates_only_on_hash_change").(bool))
+
+	if v := d.GetRawConfig().GetAttr("content_length"); v.IsKnown() && !v.IsNull() {
+		// The configured length of a stream is checked as it's uploaded.
+		if n, ok, err := objectContentLength(d); err != nil {
+			return err
+		} else if configured, _ := v.AsBigFloat().Int64(); ok && n != configured {
+			return fmt.Errorf("content_length (%d) doesn't match the size of source (%d)", configured, n)
+		}
+	} else if uploadsBody {
+		// Show the size of the body to be uploaded in the plan.
+		if n, ok, err := objectContentLength(d); err != nil {
+			return err
+		} else if ok {
+			if err := d.SetNew("content_length", n); err != nil {
+				return err
+			}
+		} else if err := d.SetNewComputed("content_length"); err != nil {
+			return err
+		}
+	}
+
+	if uploadsBody {
+		// Show the digests of the body to be uploaded in the plan.
+		if hash, ok, err := objectContentSHA256(d); err != nil {
+			return err
+		} else if ok {
+			if err := d.SetNew("content_base64_sha256", base64.StdEncoding.EncodeToString(hash)); err != nil {
+				return err
+			}
+			if err := d.SetNew("content_sha256", hex.EncodeToString(hash)); err != nil {
+				return err
+			}
+		} else {
+			for _, key := range []string{"content_base64_sha256", "content_sha256"} {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Unset object lock retention is computed, so that retention inherited from the bucket's default retention doesn't cause a difference.
+	// Retention that is no longer configured is otherwise removed.
+	if d.Id() != "" && d.GetRawConfig().GetAttr("object_lock_mode").IsNull() && d.GetRawConfig().GetAttr("object_lock_retain_until_date").IsNull() {
+		if mode := d.Get("object_lock_mode").(string); mode != "" {
+			inherited, err := objectRetentionInheritedFromBucket(ctx, meta, d, mode)
+
+			if err != nil {
+				return fmt.Errorf("reading S3 Bucket (%s) object lock configuration: %w", d.Get("bucket").(string), err)
+			}
+
+			if !inherited {
+				for _, key := range []string{"object_lock_mode", "object_lock_retain_until_date"} {
+					if err := d.SetNew(key, ""); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	if d.Id() != "" && d.HasChanges("object_lock_mode", "object_lock_retain_until_date") {
+		if err := d.SetNewComputed("object_lock_inherited"); err != nil {
+			return err
+		}
+	}
+
+	if hasObjectContentChanges(d) {
+		// A new object version inherits the bucket's current default encryption and retention for unset values.
+		for _, key := range []string{"bucket_key_enabled", "kms_key_id", "object_lock_mode", "object_lock_retain_until_date", "server_side_encryption"} {
+			if d.GetRawConfig().GetAttr(key).IsNull() {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Unless configured, the ETag of the new object version is only known once it's uploaded.
+		if d.Get("manage_etag").(bool) && d.GetRawConfig().GetAttr("etag").IsNull() {
+			if err := d.SetNewComputed("etag"); err != nil {
+				return err
+			}
+		}
+
+		// S3 chooses the checksum type of a new object version uploaded without one.
+		if d.GetRawConfig().GetAttr("checksum_type").IsNull() {
+			if err := d.SetNewComputed("checksum_type"); err != nil {
+				return err
+			}
+		}
+
+		for _, key := range []string{"expiration", "last_modified", "object_lock_inherited", "parts_count", "replication_status", "version_id"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if d.HasChange("storage_class") {
+		// The object is copied in place, creating a new object version.
+		for _, key := range []string{"expiration", "last_modified", "object_lock_inherited", "replication_status", "version_id"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("source_hash") {
+		d.SetNewComputed("version_id")
+		if d.Get("manage_etag").(bool) {
+			d.SetNewComputed("etag")
+		}
+	}
+
+	return nil
+}
+
+// objectVersionIDsCustomizeDiff marks version_ids as unknown when a new object version is written or max_versions changes.
+func objectVersionIDsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("max_versions") || (d.Get("max_versions").(int) > 0 && !d.NewValueKnown("version_id")) {
+		return d.SetNewComputed("version_ids")
+	}
+
+	return nil
+}
+
+// suppressObjectSourcePathChange suppresses a change to the path of source if source_hash is configured and unchanged,
+// e.g. when the source file is moved, so that only the content hash drives updates of the object's body.
+func suppressObjectSourcePathChange(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	o, n := d.GetChange("source_hash")
+
+	return n.(string) != "" && o.(string) == n.(string)
+}
+
+// objectBodySourceAttributes are the mutually exclusive attributes that specify an object's body.
+var objectBodySourceAttributes = []string{"content", "content_base64", "content_secret", "source", "source_bucket"}
+
+// validateObjectBodySource returns an error if more than one of the attributes that specify an object's body is configured.
+func validateObjectBodySource(config cty.Value) error {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	var configured []string
+	for _, k := range objectBodySourceAttributes {
+		if !config.GetAttr(k).IsNull() {
+			configured = append(configured, k)
+		}
+	}
+
+	if len(configured) > 1 {
+		return fmt.Errorf("only one of %s can be specified, but %s are configured", strings.Join(objectBodySourceAttributes, ", "), strings.Join(configured, ", "))
+	}
+
+	return nil
+}
+
+// validateObjectChecksumType returns an error if the checksum type can't be used with the checksum algorithm.
+// Full object checksums are only supported by the CRC algorithms, and CRC64NVME only supports full object checksums.
+func validateObjectChecksumType(algorithm types.ChecksumAlgorithm, checksumType types.ChecksumType) error {
+	switch {
+	case algorithm == "":
+		return errors.New("checksum_type requires checksum_algorithm to be set")
+	case checksumType == types.ChecksumTypeFullObject && !slices.Contains([]types.ChecksumAlgorithm{types.ChecksumAlgorithmCrc32, types.ChecksumAlgorithmCrc32c, types.ChecksumAlgorithmCrc64nvme}, algorithm),
+		checksumType == types.ChecksumTypeComposite && algorithm == types.ChecksumAlgorithmCrc64nvme:
+		return fmt.Errorf("checksum_type %s is not supported with checksum_algorithm %s", checksumType, algorithm)
+	}
+
+	return nil
+}
+
+// suppressObjectSinglePartChecksumType suppresses the difference between a configured COMPOSITE checksum type and
+// the FULL_OBJECT checksum type that S3 reports for objects uploaded in a single part.
+func suppressObjectSinglePartChecksumType(k, old, new string, d *schema.ResourceData) bool {
+	return types.ChecksumType(new) == types.ChecksumTypeComposite && types.ChecksumType(old) == types.ChecksumTypeFullObject && d.Get("parts_count").(int) == 0
+}
+
+// objectMetadataAttributes are the attributes that can be changed without uploading the object's body again, by copying the object in place with a metadata_directive of REPLACE.
+var objectMetadataAttributes = []string{
+	"cache_control",
+	"content_disposition",
+	"content_encoding",
+	"content_language",
+	"content_type",
+	"expires",
+	"metadata",
+	"website_redirect",
+}
+
+// validateObjectContentUTF8 returns an error if content isn't valid UTF-8 text, e.g. binary data that should be configured using content_base64.
+// Binary data that was converted to a string contains Unicode replacement characters (U+FFFD) in place of its invalid bytes.
+func validateObjectContentUTF8(content string) error {
+	for i, r := range content {
+		if r == utf8.RuneError {
+			return fmt.Errorf("content is not valid UTF-8 text (invalid byte sequence or replacement character at byte offset %d), use content_base64 for binary data, e.g. content_base64 = filebase64(\"path/to/file\")", i)
+		}
+	}
+
+	return nil
+}
+
+func hasObjectContentChanges(d verify.ResourceDiffer) bool {
+	return hasObjectBodyChanges(d) || d.HasChanges(objectMetadataAttributes...)
+}
+
+// hasObjectBodyChanges returns whether the object's body or encryption changes.
+// With body_updates_only_on_hash_change, changes to content, content_base64, content_secret or source alone don't change the body, only changes to etag or source_hash do.
+func hasObjectBodyChanges(d verify.ResourceDiffer) bool {
+	keys := []string{
+		"bucket_key_enabled",
+		"checksum_algorithm",
+		"checksum_type",
+		"etag",
+		"kms_key_id",
+		"server_side_encryption",
+		"source_bucket",
+		"source_hash",
+		"source_key",
+		"source_version_id",
+	}
+	if !d.Get("body_updates_only_on_hash_change").(bool) {
+		keys = append(keys, "content_base64", "content_base64_sha256", "content", "content_secret", "source")
+	}
+
+	if d.HasChanges(keys...) {
+		return true
+	}
+
+	// Changing metadata_directive or tagging_directive alone only changes the result of a copy from source_bucket.
+	if _, ok := d.GetOk("source_bucket"); ok {
+		return d.HasChanges("metadata_directive", "tagging_directive")
+	}
+
+	return false
+}
+
+// objectInPlaceCopyMetadataDirective returns the metadata directive with which an existing object is copied onto itself to apply the planned changes.
+// The returned boolean is false if the object's body must be uploaded or copied from source_bucket again.
+func objectInPlaceCopyMetadataDirective(d *schema.ResourceData) (types.MetadataDirective, bool) {
+	if _, ok := d.GetOk("source_bucket"); ok || d.IsNewResource() || hasObjectBodyChanges(d) {
+		return "", false
+	}
+
+	if o, _ := d.GetChange("storage_class"); objectStorageClassRequiresRestore(types.StorageClass(o.(string))) {
+		return "", false
+	}
+
+	metadataDirective := types.MetadataDirective(d.Get("metadata_directive").(string))
+
+	if d.HasChanges(objectMetadataAttributes...) {
+		// With body_updates_only_on_hash_change the metadata is replaced in place unless metadata_directive is COPY.
+		if metadataDirective == "" && d.Get("body_updates_only_on_hash_change").(bool) {
+			metadataDirective = types.MetadataDirectiveReplace
+		}
+
+		// With COPY the metadata can't be changed in place, and without a metadata_directive the body is uploaded again.
+		return metadataDirective, metadataDirective == types.MetadataDirectiveReplace
+	}
+
+	if metadataDirective == "" {
+		metadataDirective = types.MetadataDirectiveCopy
+	}
+
+	return metadataDirective, true
+}
+
+// objectManagesETag returns whether the object's ETag is tracked.
+// Resources created before manage_etag was added don't have it in state, and track the ETag.
+func objectManagesETag(d *schema.ResourceData) bool {
+	if v := d.GetRawState(); !v.IsNull() && v.IsKnown() && v.GetAttr("manage_etag").IsNull() {
+		return true
+	}
+
+	return d.Get("manage_etag").(bool)
+}
+
+// objectStorageClassRequiresRestore returns whether an object in the specified storage class must be restored before it can be copied.
+func objectStorageClassRequiresRestore(storageClass types.StorageClass) bool {
+	switch storageClass {
+	case types.StorageClassGlacier, types.StorageClassDeepArchive:
+		return true
+	default:
+		return false
+	}
+}
+
+// objectPartitionUnavailableStorageClasses are the storage classes that are only available in the AWS Standard partition.
+var objectPartitionUnavailableStorageClasses = []types.ObjectStorageClass{
+	types.ObjectStorageClassExpressOnezone,
+}
+
+// objectStorageClasses returns the storage classes available in the specified partition.
+func objectStorageClasses(partition string) []types.ObjectStorageClass {
+	storageClasses := enum.EnumValues[types.ObjectStorageClass]()
+
+	if partition == names.StandardPartitionID {
+		return storageClasses
+	}
+
+	return tfslices.RemoveAll(storageClasses, objectPartitionUnavailableStorageClasses...)
+}
+
+// validateObjectStorageClass returns an error if the specified storage class isn't available in the specified partition.
+// Otherwise the upload fails with an InvalidStorageClass error.
+func validateObjectStorageClass(storageClass, partition string) error {
+	storageClasses := objectStorageClasses(partition)
+
+	if !slices.Contains(storageClasses, types.ObjectStorageClass(storageClass)) {
+		return fmt.Errorf("storage_class (%s) is not available in partition (%s), valid values are: %s", storageClass, partition, strings.Join(enum.Slice(storageClasses...), ", "))
+	}
+
+	return nil
+}
+
+// objectContent returns the object body configured in content or, for bodies that mustn't be shown in plan output, content_secret.
+func objectContent(d interface{ Get(string) interface{} }) string {
+	if v := d.Get("content").(string); v != "" {
+		return v
+	}
+
+	return d.Get("content_secret").(string)
+}
+
+// objectContentLength returns the size in bytes of the object body to be uploaded.
+// The returned boolean is false if the size cannot be determined at plan time.
+func objectContentLength(d *schema.ResourceDiff) (int64, bool, error) {
+	// An unknown source_hash means that the source file is written during apply, e.g. by a local_file resource,
+	// so a file that exists at plan time may not be the one that's uploaded.
+	for _, key := range []string{"content", "content_base64", "content_secret", "source", "source_bucket", "source_hash"} {
+		if !d.NewValueKnown(key) {
+			return 0, false, nil
+		}
+	}
+
+	// The size of a copied object is only known once it's copied.
+	if _, ok := d.GetOk("source_bucket"); ok {
+		return 0, false, nil
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return 0, false, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+
+		fi, err := os.Stat(path)
+		if err != nil {
+			// The source file may not exist until apply time.
+			return 0, false, nil
+		}
+
+		// The length of a stream is only known if configured.
+		if isObjectSourceStream(path) {
+			if v := d.GetRawConfig().GetAttr("content_length"); v.IsKnown() && !v.IsNull() {
+				n, _ := v.AsBigFloat().Int64()
+				return n, true, nil
+			}
+
+			return 0, false, nil
+		}
+
+		return fi.Size(), true, nil
+	} else if v := objectContent(d); v != "" {
+		return int64(len(v)), true, nil
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		v, err := itypes.Base64Decode(v.(string))
+		if err != nil {
+			return 0, false, err
+		}
+
+		return int64(len(v)), true, nil
+	}
+
+	return 0, true, nil
+}
+
+// objectContentSHA256 returns the SHA-256 digest of the body to be uploaded, if known at plan time.
+// The digest of a source file is computed at apply time. A copied object's body isn't read, so its digest is empty.
+func objectContentSHA256(d *schema.ResourceDiff) ([]byte, bool, error) {
+	for _, key := range []string{"content", "content_base64", "content_secret", "source", "source_bucket"} {
+		if !d.NewValueKnown(key) {
+			return nil, false, nil
+		}
+	}
+
+	if _, ok := d.GetOk("source_bucket"); ok {
+		return nil, true, nil
+	}
+
+	if _, ok := d.GetOk("source"); ok {
+		return nil, false, nil
+	}
+
+	var body []byte
+	if v := objectContent(d); v != "" {
+		body = []byte(v)
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		v, err := itypes.Base64Decode(v.(string))
+		if err != nil {
+			return nil, false, err
+		}
+
+		body = v
+	}
+
+	hash := sha256.Sum256(body)
+
+	return hash[:], true, nil
+}
+
+// objectSourceSHA256 returns the SHA-256 digest of the source file of the object body to be uploaded.
+// The returned boolean is false if the source file isn't known, doesn't exist or is written during apply.
+func objectSourceSHA256(d *schema.ResourceDiff) ([]byte, bool, error) {
+	v, ok := d.GetOk("source")
+	if !ok || !d.NewValueKnown("source") || !d.NewValueKnown("source_hash") {
+		return nil, false, nil
+	}
+
+	source := v.(string)
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return nil, false, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	// A stream can only be read once, when it's uploaded.
+	if isObjectSourceStream(path) {
+		return nil, false, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		// The source file may not exist until apply time.
+		return nil, false, nil
+	}
+	defer file.Close()
+
+	hash, err := computeObjectSHA256(file)
+	if err != nil {
+		return nil, false, fmt.Errorf("computing SHA-256 digest of source (%s): %w", path, err)
+	}
+
+	return hash, true, nil
+}
+
+// contentBase64SHA256 returns the base64-encoded SHA-256 digest of the decoded content_base64 value.
+func contentBase64SHA256(v string) (string, error) {
+	b, err := itypes.Base64Decode(v)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(b)
+
+	return base64.StdEncoding.EncodeToString(hash[:]), nil
+}
+
+// objectContentTypeFromDiff returns the detected MIME type of the object body to be uploaded.
+// The returned boolean is false if the type cannot be determined at plan time.
+func objectContentTypeFromDiff(d *schema.ResourceDiff) (string, bool, error) {
+	for _, key := range []string{"content", "content_base64", "content_secret", "key", "source", "source_hash"} {
+		if !d.NewValueKnown(key) {
+			return "", false, nil
+		}
+	}
+
+	source := d.Get("source").(string)
+	if source != "" {
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return "", false, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+
+		if _, err := os.Stat(path); err != nil {
+			// The source file may not exist until apply time.
+			return "", false, nil
+		}
+	}
+
+	v, err := detectObjectContentType(d.Get("key").(string), source, objectContent(d), d.Get("content_base64").(string))
+	if err != nil {
+		return "", false, err
+	}
+
+	return v, true, nil
+}
+
+// detectObjectContentType returns the MIME type of the object body.
+// The type is determined from the extension of the source file, or of the object key if there is no source file,
+// falling back to sniffing the first 512 bytes of the body as with http.DetectContentType.
+func detectObjectContentType(key, source, content, contentBase64 string) (string, error) {
+	name := key
+	if source != "" {
+		name = source
+	}
+
+	if v := mime.TypeByExtension(filepath.Ext(name)); v != "" {
+		return v, nil
+	}
+
+	var data []byte
+	if source != "" {
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return "", fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+
+		// A stream can only be read once, when it's uploaded.
+		if isObjectSourceStream(path) {
+			return objectDefaultContentType, nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+		defer file.Close()
+
+		data = make([]byte, 512)
+		n, err := io.ReadFull(file, data)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return "", fmt.Errorf("reading S3 object source (%s): %w", path, err)
+		}
+		data = data[:n]
+	} else if content != "" {
+		data = []byte(content)
+	} else if contentBase64 != "" {
+		v, err := itypes.Base64Decode(contentBase64)
+		if err != nil {
+			return "", err
+		}
+		data = v
+	}
+
+	return http.DetectContentType(data), nil
+}
+
+// findObjectByBucketAndKey returns the current version of the specified object.
+// If etag is not empty the object is only found if its ETag matches (If-Match), so callers that don't track the object's ETag,
+// e.g. an aws_s3_object with manage_etag = false, must pass an empty etag.
+// If checksumAlgorithm is not empty the object's checksums, e.g. its SHA-256 checksum, are also returned.
+func findObjectByBucketAndKey(ctx context.Context, conn *s3.Client, bucket, key, etag, checksumAlgorithm string, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if checksumAlgorithm != "" {
+		input.ChecksumMode = types.ChecksumModeEnabled
+	}
+	if etag != "" {
+		input.IfMatch = aws.String(etag)
+	}
+
+	return findObject(ctx, conn, input, optFns...)
+}
+
+func findObject(ctx context.Context, conn *s3.Client, input *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	output, err := conn.HeadObject(ctx, input, optFns...)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// findObjectWithRetry is findObject, retrying while the object isn't found, e.g. immediately after it's created.
+func findObjectWithRetry(ctx context.Context, conn *s3.Client, input *s3.HeadObjectInput, timeout time.Duration, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, timeout, func() (interface{}, error) {
+		return findObject(ctx, conn, input, optFns...)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*s3.HeadObjectOutput), nil
+}
+
+// findObjectChecksum returns the checksum attributes of the specified object version, including its checksum type.
+func findObjectChecksum(ctx context.Context, conn *s3.Client, bucket, key, versionID string, optFns ...func(*s3.Options)) (*types.Checksum, error) {
+	input := &s3.GetObjectAttributesInput{
+		Bucket:           aws.String(bucket),
+		Key:              aws.String(key),
+		ObjectAttributes: []types.ObjectAttributes{types.ObjectAttributesChecksum},
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	output, err := conn.GetObjectAttributes(ctx, input, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchKey) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Checksum == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Checksum, nil
+}
+
+func findObjectACL(ctx context.Context, conn *s3.Client, bucket, key string, optFns ...func(*s3.Options)) (*s3.GetObjectAclOutput, error) {
+	input := &s3.GetObjectAclInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	output, err := conn.GetObjectAcl(ctx, input, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchKey) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenObjectACL(apiObject *s3.GetObjectAclOutput) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+
+	if len(apiObject.Grants) > 0 {
+		m["grant"] = flattenGrants(apiObject.Grants)
+	}
+
+	if apiObject.Owner != nil {
+		m["owner"] = flattenOwner(apiObject.Owner)
+	}
+
+	return []interface{}{m}
+}
+
+// tagsFromKeyPattern returns the tags derived from the specified object key.
+// Each tag value is a template expanded using the submatches of the pattern, as with regexp.Regexp.Expand.
+// An error is returned if the pattern does not match the key.
+func tagsFromKeyPattern(ctx context.Context, tfMap map[string]interface{}, key string) (tftags.KeyValueTags, error) {
+	pattern := tfMap["pattern"].(string)
+	re, err := regexp.Compile(pattern)
+
+	if err != nil {
+		return nil, fmt.Errorf("compiling tags_from_key_pattern pattern (%s): %w", pattern, err)
+	}
+
+	match := re.FindStringSubmatchIndex(key)
+
+	if match == nil {
+		return nil, fmt.Errorf("S3 Object key (%s) does not match tags_from_key_pattern pattern (%s)", key, pattern)
+	}
+
+	tags := make(map[string]string)
+	for k, v := range tfMap["tags"].(map[string]interface{}) {
+		tags[k] = string(re.ExpandString(nil, v.(string), key, match))
+	}
+
+	return tftags.New(ctx, tags), nil
+}
+
+// objectPartsCount returns the number of parts of a multipart object, or 0 if the object wasn't uploaded as a multipart upload.
+// HeadObject only returns the parts count if a part number is requested, so fall back to the ETag of the multipart object ("<md5>-<parts count>").
+func objectPartsCount(output *s3.HeadObjectOutput) int32 {
+	if v := aws.ToInt32(output.PartsCount); v > 0 {
+		return v
+	}
+
+	etag := strings.Trim(aws.ToString(output.ETag), `"`)
+	if i := strings.LastIndex(etag, "-"); i >= 0 {
+		if v, err := strconv.ParseInt(etag[i+1:], 10, 32); err == nil {
+			return int32(v)
+		}
+	}
+
+	return 0
+}
+
+// flattenObjectExpiration flattens the value of the x-amz-expiration header, e.g.
+// `expiry-date="Fri, 23 Dec 2012 00:00:00 GMT", rule-id="picture-deletion-rule"`.
+// The header is only returned if the object matches a lifecycle expiration rule.
+func flattenObjectExpiration(v string) []interface{} {
+	if v == "" {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for _, m := range objectExpirationRegex.FindAllStringSubmatch(v, -1) {
+		switch key, value := m[1], m[2]; key {
+		case "expiry-date":
+			if t, err := time.Parse(time.RFC1123, value); err == nil {
+				tfMap["expiry_date"] = flattenObjectDate(&t)
+			} else {
+				tfMap["expiry_date"] = value
+			}
+		case "rule-id":
+			// The rule ID is URL encoded.
+			if ruleID, err := url.QueryUnescape(value); err == nil {
+				tfMap["rule_id"] = ruleID
+			} else {
+				tfMap["rule_id"] = value
+			}
+		}
+	}
+
+	if len(tfMap) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{tfMap}
+}
+
+var objectExpirationRegex = regexache.MustCompile(`([a-z-]+)="([^"]*)"`)
+
+func expandObjectDate(v string) *time.Time {
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil
+	}
+
+	return aws.Time(t)
+}
+
+func flattenObjectDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// suppressEquivalentObjectDate suppresses differences between RFC 3339 timestamps of the same instant,
+// e.g. "2024-01-01T00:00:00Z", "2024-01-01T00:00:00.000Z" and "2024-01-01T01:00:00+01:00".
+func suppressEquivalentObjectDate(k, old, new string, d *schema.ResourceData) bool {
+	o, n := expandObjectDate(old), expandObjectDate(new)
+	if o == nil || n == nil {
+		return false
+	}
+
+	return o.Equal(*n)
+}
+
+// checkObjectKeyNormalization returns a warning if the S3 object's key differs from the configured key, as cleaned by sdkv1CompatibleCleanKey.
+// The configured key is kept in state as is, so this is a warning rather than an error, unless the cleaned key is empty.
+func checkObjectKeyNormalization(key string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	path := cty.GetAttrPath("key")
+
+	cleanKey := sdkv1CompatibleCleanKey(key)
+
+	if cleanKey == "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid S3 Object key",
+			Detail:        fmt.Sprintf("The key (%s) is empty once a leading \"./\" and leading \"/\"s are removed. A directory placeholder object's key must end in \"/\", e.g. \"folder/\".", key),
+			AttributePath: path,
+		})
+
+		return diags
+	}
+
+	if cleanKey != key {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "S3 Object key is normalized",
+			Detail:        fmt.Sprintf("A leading \"./\" and leading \"/\"s are removed from the key (%s) and repeated \"/\"s are replaced with a single \"/\", so the S3 Object's key is %q. Specify the key as %q, or set normalize_key to false, to remove this warning.", key, cleanKey, cleanKey),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// objectKeyContentSHA256Placeholder is replaced in an object's key with the hex-encoded SHA-256 digest of the object's content.
+const objectKeyContentSHA256Placeholder = "${content_sha256}"
+
+// objectKey returns the key of an S3 object, with any placeholder for the digest of its content resolved, as cleaned by sdkv1CompatibleCleanKey.
+func objectKey(d verify.ResourceDiffer) string {
+	return sdkv1CompatibleCleanKey(resolveObjectKey(d.Get("key").(string), d.Get("content_sha256").(string)))
+}
+
+// resolveObjectKey replaces any placeholder for the digest of an object's content in the specified key.
+func resolveObjectKey(key, contentSHA256 string) string {
+	return strings.ReplaceAll(key, objectKeyContentSHA256Placeholder, contentSHA256)
+}
+
+// sdkv1CompatibleCleanKey returns an AWS SDK for Go v1 compatible clean key.
+// DisableRestProtocolURICleaning was false on the standard S3Conn, so to ensure backwards
+// compatibility we must "clean" the configured key before passing to AWS SDK for Go v2 APIs.
+// See https://docs.aws.amazon.com/sdk-for-go/api/service/s3/#hdr-Automatic_URI_cleaning.
+// See https://github.com/aws/aws-sdk-go/blob/cf903c8c543034654bb8f53b5f9d6454fdb2117f/private/protocol/rest/build.go#L247-L258.
+func sdkv1CompatibleCleanKey(key string) string {
+	// Remove leading './'.
+	key = strings.TrimPrefix(key, "./")
+	// We are effectively ignoring all leading '/'s and treating multiple '/'s as a single '/'.
+	key = strings.TrimLeft(key, "/")
+	key = regexache.MustCompile(`/+`).ReplaceAllString(key, "/")
+	return key
+}
+
+func ignoreProviderDefaultTags(ctx context.Context, d verify.ResourceDiffer) bool {
+	if v, ok := d.GetOk("override_provider"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if data := expandOverrideProviderModel(ctx, v.([]interface{})[0].(map[string]interface{})); data != nil && data.DefaultTagsConfig != nil {
+			return len(data.DefaultTagsConfig.Tags) == 0
+		}
+	}
+
+	return false
+}
+
+type overrideProviderModel struct {
+	DefaultTagsConfig *tftags.DefaultConfig
+}
+
+func expandOverrideProviderModel(ctx context.Context, tfMap map[string]interface{}) *overrideProviderModel {
+	if tfMap == nil {
+		return nil
+	}
+
+	data := &overrideProviderModel{}
+
+	if v, ok := tfMap["default_tags"].([]interface{}); ok && len(v) > 0 {
+		if v[0] != nil {
+			data.DefaultTagsConfig = expandDefaultTags(ctx, v[0].(map[string]interface{}))
+		} else {
+			// Ensure that DefaultTagsConfig is not nil as it's checked in ignoreProviderDefaultTags.
+			data.DefaultTagsConfig = expandDefaultTags(ctx, map[string]interface{}{})
+		}
+	}
+
+	return data
+}
+
+func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	data := &tftags.DefaultConfig{}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+		data.Tags = tftags.New(ctx, v)
+	}
+
+	return data
+}
+
+// objectChecksums holds the locally computed, base64-encoded checksums of an object body.
+type objectChecksums struct {
+	algorithm types.ChecksumAlgorithm
+	// object is the checksum of the whole object body.
+	object string
+	// parts are the checksums of each part of the object body, as uploaded by the S3 upload manager.
+	parts []string
+}
+
+func newChecksumHash(algorithm types.ChecksumAlgorithm) (hash.Hash, error) {
+	switch algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case types.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case types.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case types.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+}
+
+// computeObjectChecksums computes the checksums of the specified object body.
+// partSize and maxUploadParts must match the configuration of the S3 upload manager used to upload the body.
+// The body is rewound to its start on success.
+func computeObjectChecksums(body io.ReadSeeker, algorithm types.ChecksumAlgorithm, partSize int64, maxUploadParts int32) (*objectChecksums, error) {
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	// The upload manager increases the part size for large objects.
+	if size/partSize >= int64(maxUploadParts) {
+		partSize = (size / int64(maxUploadParts)) + 1
+	}
+
+	objectHash, err := newChecksumHash(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &objectChecksums{
+		algorithm: algorithm,
+	}
+
+	for remaining := size; remaining > 0; remaining -= partSize {
+		partHash, err := newChecksumHash(algorithm)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := io.CopyN(io.MultiWriter(objectHash, partHash), body, min(partSize, remaining)); err != nil {
+			return nil, err
+		}
+
+		result.parts = append(result.parts, base64.StdEncoding.EncodeToString(partHash.Sum(nil)))
+	}
+
+	result.object = base64.StdEncoding.EncodeToString(objectHash.Sum(nil))
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// verify compares the locally computed checksums with those returned by S3 after upload.
+func (c *objectChecksums) verify(output *manager.UploadOutput) error {
+	// Single PutObject request.
+	if output.UploadID == "" {
+		if got := aws.ToString(uploadOutputChecksum(output, c.algorithm)); got != c.object {
+			return fmt.Errorf("%s checksum mismatch: S3 returned %q, computed %q", c.algorithm, got, c.object)
+		}
+
+		return nil
+	}
+
+	// Multipart upload.
+	if got, want := len(output.CompletedParts), len(c.parts); got != want {
+		return fmt.Errorf("multipart upload part count mismatch: S3 returned %d, computed %d", got, want)
+	}
+
+	for _, part := range output.CompletedParts {
+		i := int(aws.ToInt32(part.PartNumber)) - 1
+		if i < 0 || i >= len(c.parts) {
+			return fmt.Errorf("unexpected multipart upload part number: %d", aws.ToInt32(part.PartNumber))
+		}
+
+		if got, want := aws.ToString(completedPartChecksum(part, c.algorithm)), c.parts[i]; got != want {
+			return fmt.Errorf("part %d %s checksum mismatch: S3 returned %q, computed %q", i+1, c.algorithm, got, want)
+		}
+	}
+
+	return nil
+}
+
+func uploadOutputChecksum(output *manager.UploadOutput, algorithm types.ChecksumAlgorithm) *string {
+	switch algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return output.ChecksumCRC32
+	case types.ChecksumAlgorithmCrc32c:
+		return output.ChecksumCRC32C
+	case types.ChecksumAlgorithmSha1:
+		return output.ChecksumSHA1
+	case types.ChecksumAlgorithmSha256:
+		return output.ChecksumSHA256
+	default:
+		return nil
+	}
+}
+
+func completedPartChecksum(part types.CompletedPart, algorithm types.ChecksumAlgorithm) *string {
+	switch algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return part.ChecksumCRC32
+	case types.ChecksumAlgorithmCrc32c:
+		return part.ChecksumCRC32C
+	case types.ChecksumAlgorithmSha1:
+		return part.ChecksumSHA1
+	case types.ChecksumAlgorithmSha256:
+		return part.ChecksumSHA256
+	default:
+		return nil
+	}
+}
+
+// computeObjectSHA256 returns the SHA-256 digest of the specified object body.
+// The body is streamed through the hash and then rewound, so that it can be uploaded.
+func computeObjectSHA256(body io.ReadSeeker) ([]byte, error) {
+	return computeObjectDigest(body, sha256.New())
+}
+
+// computeObjectMD5 returns the MD5 digest of the specified object body, which is the ETag of an object uploaded with a single request and not encrypted with KMS.
+// The body is streamed through the hash and then rewound, so that it can be uploaded.
+func computeObjectMD5(body io.ReadSeeker) ([]byte, error) {
+	return computeObjectDigest(body, md5.New())
+}
+
+func computeObjectDigest(body io.ReadSeeker, hash hash.Hash) ([]byte, error) {
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	if _, err := io.Copy(hash, body); err != nil {
+		return nil, err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return hash.Sum(nil), nil
+}
+
+// verifyObjectETag compares the locally computed MD5 digest of an object body with the ETag returned by S3 after upload.
+// The ETag is only the MD5 digest of the body for objects uploaded with a single PutObject request that aren't encrypted with KMS, so other objects aren't verified.
+func verifyObjectETag(output *manager.UploadOutput, contentMD5 []byte) error {
+	if output.UploadID != "" {
+		return nil
+	}
+
+	switch output.ServerSideEncryption {
+	case types.ServerSideEncryptionAwsKms, types.ServerSideEncryptionAwsKmsDsse:
+		return nil
+	}
+
+	if got, want := strings.Trim(aws.ToString(output.ETag), `"`), hex.EncodeToString(contentMD5); got != want {
+		return fmt.Errorf("ETag mismatch: S3 returned %q, computed %q", got, want)
+	}
+
+	return nil
+}
+
+// newObjectUploadRetryer returns a Retryer for object uploads wrapping the specified Retryer.
+// SlowDown and RequestTimeout errors are always retried.
+// If configured via `upload_retry`, the maximum number of attempts and the base delay of the exponential backoff are overridden.
+// Retries still draw from the wrapped Retryer's client-side retry quota.
+func newObjectUploadRetryer(r aws.Retryer, tfMap map[string]interface{}) aws.Retryer {
+	r = retry_sdkv2.AddWithErrorCodes(r, errCodeRequestTimeout, errCodeSlowDown)
+
+	if tfMap == nil {
+		return r
+	}
+
+	if v, ok := tfMap["max_attempts"].(int); ok && v > 0 {
+		r = retry_sdkv2.AddWithMaxAttempts(r, v)
+	}
+
+	if v, ok := tfMap["base_delay"].(string); ok && v != "" {
+		if v, err := time.ParseDuration(v); err == nil && v > 0 {
+			r = &objectUploadRetryer{
+				RetryerV2: r.(aws.RetryerV2),
+				backoff: &objectUploadBackoff{
+					baseDelay: v,
+					maxDelay:  retry_sdkv2.DefaultMaxBackoff,
+				},
+			}
+		}
+	}
+
+	return r
+}
+
+// objectUploadRetryer overrides the wrapped Retryer's backoff delay.
+type objectUploadRetryer struct {
+	aws.RetryerV2
+	backoff retry_sdkv2.BackoffDelayer
+}
+
+func (r *objectUploadRetryer) RetryDelay(attempt int, err error) (time.Duration, error) {
+	return r.backoff.BackoffDelay(attempt, err)
+}
+
+// objectUploadBackoff implements exponential backoff with full jitter.
+type objectUploadBackoff struct {
+	baseDelay time.Duration
+	maxDelay  time.Duration
+}
+
+func (b *objectUploadBackoff) BackoffDelay(attempt int, _ error) (time.Duration, error) {
+	delay := math.Min(float64(b.baseDelay)*math.Pow(2, float64(max(attempt-1, 0))), float64(b.maxDelay))
+
+	return time.Duration(rand.Int63n(int64(delay) + 1)), nil
+}
+
+const (
+	// objectCopyMaxSize is the maximum size of an object that can be copied with a single CopyObject request.
+	objectCopyMaxSize int64 = 5 * 1024 * 1024 * 1024 // 5 GiB
+	// objectCopyMinPartSize is the minimum size of each part of a multipart copy.
+	objectCopyMinPartSize int64 = 512 * 1024 * 1024 // 512 MiB
+)
+
+// objectCopySource identifies the source object of a server-side copy.
+type objectCopySource struct {
+	bucket    string
+	key       string
+	versionID string
+}
+
+func (s objectCopySource) String() string {
+	if s.versionID == "" {
+		return fmt.Sprintf("%s/%s", s.bucket, s.key)
+	}
+
+	return fmt.Sprintf("%s/%s?versionId=%s", s.bucket, s.key, s.versionID)
+}
+
+// copySource returns the value of the x-amz-copy-source header.
+func (s objectCopySource) copySource() string {
+	v := escapeObjectKey(s.bucket + "/" + s.key)
+	if s.versionID != "" {
+		v += "?versionId=" + url.QueryEscape(s.versionID)
+	}
+
+	return v
+}
+
+// copyObjectFrom copies the source object server-side to the object described by the specified PutObject input.
+// Objects larger than 5 GiB are copied with a multipart upload using UploadPartCopy.
+// If the PutObject input's IfMatch is set, the copy is conditional on the source object's ETag, which for an in-place copy is the object's own.
+// Unless taggingDirective is COPY, the destination object's tags are those of the PutObject input.
+// The PutObject input's body isn't read, the destination object's body is always the source object's whole body.
+func copyObjectFrom(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, source objectCopySource, metadataDirective types.MetadataDirective, taggingDirective types.TaggingDirective, optFns ...func(*s3.Options)) error {
+	headInput := &s3.HeadObjectInput{
+		Bucket: aws.String(source.bucket),
+		Key:    aws.String(source.key),
+	}
+	if source.versionID != "" {
+		headInput.VersionId = aws.String(source.versionID)
+	}
+
+	sourceObject, err := findObject(ctx, conn, headInput, optFns...)
+
+	if err != nil {
+		return fmt.Errorf("reading source S3 Object (%s): %w", source, err)
+	}
+
+	if aws.ToInt64(sourceObject.ContentLength) > objectCopyMaxSize {
+		return multipartCopyObjectFrom(ctx, conn, input, source, sourceObject, metadataDirective, taggingDirective, optFns...)
+	}
+
+	if taggingDirective == "" {
+		taggingDirective = types.TaggingDirectiveReplace
+	}
+
+	copyInput := &s3.CopyObjectInput{
+		ACL:                       input.ACL,
+		Bucket:                    input.Bucket,
+		BucketKeyEnabled:          input.BucketKeyEnabled,
+		CacheControl:              input.CacheControl,
+		ChecksumAlgorithm:         input.ChecksumAlgorithm,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		ContentType:               input.ContentType,
+		CopySource:                aws.String(source.copySource()),
+		CopySourceIfMatch:         input.IfMatch,
+		Expires:                   input.Expires,
+		GrantFullControl:          input.GrantFullControl,
+		GrantRead:                 input.GrantRead,
+		GrantReadACP:              input.GrantReadACP,
+		GrantWriteACP:             input.GrantWriteACP,
+		Key:                       input.Key,
+		Metadata:                  input.Metadata,
+		MetadataDirective:         metadataDirective,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		StorageClass:              input.StorageClass,
+		Tagging:                   input.Tagging,
+		TaggingDirective:          taggingDirective,
+		WebsiteRedirectLocation:   input.WebsiteRedirectLocation,
+	}
+	if taggingDirective == types.TaggingDirectiveCopy {
+		copyInput.Tagging = nil
+	}
+	// Directory buckets don't support object tags, and CopyObject fails if a tagging directive is sent.
+	if isDirectoryBucket(aws.ToString(input.Bucket)) {
+		copyInput.Tagging = nil
+		copyInput.TaggingDirective = ""
+	}
+
+	_, err = conn.CopyObject(ctx, copyInput, optFns...)
+
+	return err
+}
+
+// multipartCopyObjectFrom copies the source object server-side using a multipart upload.
+// Unlike CopyObject, a multipart upload doesn't copy the source object's metadata or tags, so they're copied explicitly unless replaced.
+func multipartCopyObjectFrom(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, source objectCopySource, sourceObject *s3.HeadObjectOutput, metadataDirective types.MetadataDirective, taggingDirective types.TaggingDirective, optFns ...func(*s3.Options)) error {
+	createInput := &s3.CreateMultipartUploadInput{
+		ACL:                       input.ACL,
+		Bucket:                    input.Bucket,
+		BucketKeyEnabled:          input.BucketKeyEnabled,
+		CacheControl:              input.CacheControl,
+		ChecksumAlgorithm:         input.ChecksumAlgorithm,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		ContentType:               input.ContentType,
+		Expires:                   input.Expires,
+		GrantFullControl:          input.GrantFullControl,
+		GrantRead:                 input.GrantRead,
+		GrantReadACP:              input.GrantReadACP,
+		GrantWriteACP:             input.GrantWriteACP,
+		Key:                       input.Key,
+		Metadata:                  input.Metadata,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		StorageClass:              input.StorageClass,
+		Tagging:                   input.Tagging,
+		WebsiteRedirectLocation:   input.WebsiteRedirectLocation,
+	}
+
+	if metadataDirective != types.MetadataDirectiveReplace {
+		createInput.CacheControl = sourceObject.CacheControl
+		createInput.ContentDisposition = sourceObject.ContentDisposition
+		createInput.ContentEncoding = sourceObject.ContentEncoding
+		createInput.ContentLanguage = sourceObject.ContentLanguage
+		createInput.ContentType = sourceObject.ContentType
+		createInput.Expires = sourceObject.Expires
+		createInput.Metadata = sourceObject.Metadata
+		createInput.WebsiteRedirectLocation = sourceObject.WebsiteRedirectLocation
+	}
+
+	if taggingDirective == types.TaggingDirectiveCopy && !isDirectoryBucket(aws.ToString(input.Bucket)) {
+		tags, err := objectListTags(ctx, conn, source.bucket, source.key, source.versionID, optFns...)
+
+		if err != nil {
+			return fmt.Errorf("listing tags for source S3 Object (%s): %w", source, err)
+		}
+
+		createInput.Tagging = nil
+		if tags = tags.IgnoreAWS(); len(tags) > 0 {
+			createInput.Tagging = aws.String(tags.URLEncode())
+		}
+	}
+
+	output, err := conn.CreateMultipartUpload(ctx, createInput, optFns...)
+
+	if err != nil {
+		return fmt.Errorf("creating multipart upload: %w", err)
+	}
 
-		if err != nil {
-			return fmt.Errorf("reading default S3 KMS key (%s): %s", defaultKMSKeyAlias, err)
+	uploadID := aws.ToString(output.UploadId)
+
+	parts, err := uploadPartCopies(ctx, conn, input, source, uploadID, aws.ToInt64(sourceObject.ContentLength), optFns...)
+
+	if err != nil {
+		abortInput := &s3.AbortMultipartUploadInput{
+			Bucket:   input.Bucket,
+			Key:      input.Key,
+			UploadId: aws.String(uploadID),
 		}
 
-		if sseKMSKeyID != aws.ToString(keyMetadata.Arn) {
-			log.Printf("[DEBUG] S3 object is encrypted using a non-default KMS key: %s", sseKMSKeyID)
-			d.Set("kms_key_id", sseKMSKeyID)
+		if _, err := conn.AbortMultipartUpload(ctx, abortInput, optFns...); err != nil {
+			log.Printf("[WARN] Aborting S3 Bucket (%s) Object (%s) multipart upload (%s): %s", aws.ToString(input.Bucket), aws.ToString(input.Key), uploadID, err)
 		}
+
+		return err
+	}
+
+	completeInput := &s3.CompleteMultipartUploadInput{
+		Bucket:  input.Bucket,
+		IfMatch: input.IfMatch,
+		Key:     input.Key,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		UploadId: aws.String(uploadID),
+	}
+
+	if _, err := conn.CompleteMultipartUpload(ctx, completeInput, optFns...); err != nil {
+		return fmt.Errorf("completing multipart upload (%s): %w", uploadID, err)
 	}
 
 	return nil
 }
 
-func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(map[string]interface{})
+// uploadPartCopies copies the source object in parts to the specified multipart upload.
+func uploadPartCopies(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, source objectCopySource, uploadID string, size int64, optFns ...func(*s3.Options)) ([]types.CompletedPart, error) {
+	maxParts := int64(manager.MaxUploadParts)
+	partSize := max(objectCopyMinPartSize, (size+maxParts-1)/maxParts)
 
-	for k := range value {
-		if k != strings.ToLower(k) {
-			errors = append(errors, fmt.Errorf(
-				"Metadata must be lowercase only. Offending key: %q", k))
+	var parts []types.CompletedPart
+	for partNumber, offset := int32(1), int64(0); offset < size; partNumber, offset = partNumber+1, offset+partSize {
+		partInput := &s3.UploadPartCopyInput{
+			Bucket:            input.Bucket,
+			CopySource:        aws.String(source.copySource()),
+			CopySourceIfMatch: input.IfMatch,
+			CopySourceRange:   aws.String(fmt.Sprintf("bytes=%d-%d", offset, min(offset+partSize, size)-1)),
+			Key:               input.Key,
+			PartNumber:        aws.Int32(partNumber),
+			UploadId:          aws.String(uploadID),
+		}
+
+		output, err := conn.UploadPartCopy(ctx, partInput, optFns...)
+
+		if err != nil {
+			return nil, fmt.Errorf("copying part %d: %w", partNumber, err)
+		}
+
+		part := types.CompletedPart{
+			PartNumber: aws.Int32(partNumber),
+		}
+		if v := output.CopyPartResult; v != nil {
+			part.ChecksumCRC32 = v.ChecksumCRC32
+			part.ChecksumCRC32C = v.ChecksumCRC32C
+			part.ChecksumSHA1 = v.ChecksumSHA1
+			part.ChecksumSHA256 = v.ChecksumSHA256
+			part.ETag = v.ETag
+		}
+
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
+// isObjectSourceStream returns whether the specified source file is a stream, e.g. a named pipe, that can't be seeked or read more than once.
+func isObjectSourceStream(path string) bool {
+	fi, err := os.Stat(path)
+
+	return err == nil && !fi.IsDir() && !fi.Mode().IsRegular()
+}
+
+// uploadObjectStream uploads an object whose body is read from a stream that can't be seeked, returning the SHA-256 digest of the body.
+// The upload manager buffers each part of the stream in memory and sends it with a Content-Length header.
+// The S3 client only calculates request checksums when an operation requires them, so a part is only sent as an aws-chunked body
+// with a checksum trailer when checksum_algorithm is set. Some S3-compatible stores don't support aws-chunked bodies.
+// If contentLength isn't negative it's sent as the Content-Length of a single part upload, and the part size is increased so
+// that a stream of that length is uploaded within the maximum number of parts.
+func uploadObjectStream(ctx context.Context, uploader *manager.Uploader, input *s3.PutObjectInput, stream io.Reader, contentLength int64) (*manager.UploadOutput, []byte, error) {
+	hash := sha256.New()
+	// The reader doesn't implement io.Seeker, so the upload manager doesn't try to determine the stream's length by seeking.
+	body := &objectStreamReader{r: io.TeeReader(stream, hash)}
+	input.Body = body
+
+	if contentLength >= 0 {
+		input.ContentLength = aws.Int64(contentLength)
+		uploader.PartSize = objectStreamPartSize(contentLength, uploader.PartSize, uploader.MaxUploadParts)
+	}
+
+	output, err := uploader.Upload(ctx, input)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if contentLength >= 0 && body.n != contentLength {
+		return nil, nil, fmt.Errorf("read %d bytes from stream, expected content_length of %d bytes", body.n, contentLength)
+	}
+
+	return output, hash.Sum(nil), nil
+}
+
+// objectStreamPartSize returns the size of each part with which a stream of the specified length is uploaded within maxParts parts.
+func objectStreamPartSize(contentLength, partSize int64, maxParts int32) int64 {
+	return max(manager.MinUploadPartSize, partSize, (contentLength+int64(maxParts)-1)/int64(maxParts))
+}
+
+// objectStreamReader counts the bytes read from a stream.
+type objectStreamReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *objectStreamReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+
+	return n, err
+}
+
+// findObjectBucketVersioningStatus returns the versioning status of the specified bucket, Disabled if versioning has never been enabled.
+func findObjectBucketVersioningStatus(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) (string, error) {
+	input := &s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketVersioning(ctx, input, optFns...)
+
+	if err != nil {
+		return "", err
+	}
+
+	status := string(output.Status)
+	if status == "" {
+		status = bucketVersioningStatusDisabled
+	}
+
+	return status, nil
+}
+
+// objectUnversionedOverwriteWarning returns a warning if the specified object is overwritten in a bucket without versioning enabled.
+// The object's previous content can't then be recovered, and a concurrent write to the object is silently lost.
+// Errors reading the bucket's versioning status, e.g. without s3:GetBucketVersioning permission, are logged rather than returned.
+func objectUnversionedOverwriteWarning(ctx context.Context, conn *s3.Client, bucket, key string, optFns ...func(*s3.Options)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Directory buckets don't support versioning, and a bucket's versioning can't be read via an access point.
+	if isDirectoryBucket(bucket) || arn.IsARN(bucket) {
+		return diags
+	}
+
+	status, err := findObjectBucketVersioningStatus(ctx, conn, bucket, optFns...)
+
+	if err != nil {
+		log.Printf("[WARN] reading S3 Bucket (%s) versioning: %s", bucket, err)
+		return diags
+	}
+
+	if status == string(types.BucketVersioningStatusEnabled) {
+		return diags
+	}
+
+	return sdkdiag.AppendWarningf(diags, "S3 Bucket (%s) versioning is %s, so updating Object (%s) overwrites its current content. The previous content can't be recovered, and a concurrent write to the object is silently lost. Enable versioning on the bucket to keep previous versions of the object.", bucket, status, key)
+}
+
+// findObjectVersionIDs returns the IDs of up to maxVersions versions of the specified object, most recent first.
+// Delete markers aren't included.
+func findObjectVersionIDs(ctx context.Context, conn *s3.Client, bucket, key string, maxVersions int, optFns ...func(*s3.Options)) ([]string, error) {
+	input := &s3.ListObjectVersionsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(key),
+	}
+	var versionIDs []string
+
+	pages := s3.NewListObjectVersionsPaginator(conn, input)
+	for pages.HasMorePages() && len(versionIDs) < maxVersions {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Versions are listed in key order, most recent first, so the object's versions are listed before those of any other key with the same prefix.
+		for _, v := range page.Versions {
+			if aws.ToString(v.Key) != key || len(versionIDs) == maxVersions {
+				return versionIDs, nil
+			}
+
+			versionIDs = append(versionIDs, aws.ToString(v.VersionId))
 		}
 	}
+
+	return versionIDs, nil
+}
+
+// expandObjectExpires parses an Expires date and time in RFC1123 or RFC3339 format.
+func expandObjectExpires(s string) (*time.Time, error) {
+	if t, err := http.ParseTime(s); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+
+	if err != nil {
+		return nil, fmt.Errorf("%q is not in RFC1123 or RFC3339 format", s)
+	}
+
+	return &t, nil
+}
+
+func validateObjectExpires(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := expandObjectExpires(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
 	return
 }
 
-func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
-	if hasObjectContentChanges(d) {
-		return d.SetNewComputed("version_id")
+// suppressEquivalentObjectExpires suppresses differences between Expires values that represent the same instant,
+// e.g. the configured RFC3339 value and the RFC1123 value returned by S3.
+func suppressEquivalentObjectExpires(k, old, new string, d *schema.ResourceData) bool {
+	o, err := expandObjectExpires(old)
+	if err != nil {
+		return false
 	}
 
-	if d.HasChange("source_hash") {
-		d.SetNewComputed("version_id")
-		d.SetNewComputed("etag")
+	n, err := expandObjectExpires(new)
+	if err != nil {
+		return false
+	}
+
+	return o.Equal(*n)
+}
+
+// objectIfMatchError explains a failed conditional write of an object, see if_match_on_update.
+func objectIfMatchError(err error, etag string) error {
+	if etag == "" {
+		return err
+	}
+
+	// A concurrent conditional write fails with a 409 ConditionalRequestConflict error.
+	if !tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusPreconditionFailed) && !tfawserr.ErrCodeEquals(err, errCodeConditionalRequestConflict) {
+		return err
+	}
+
+	return fmt.Errorf("the object has been modified since it was last read (ETag %s), refresh its state, e.g. with terraform apply -refresh-only, and plan again: %w", etag, err)
+}
+
+// objectIfNoneMatchError explains a failed conditional write of an object, see if_none_match_etag.
+func objectIfNoneMatchError(err error, etag string) error {
+	if etag == "" {
+		return err
+	}
+
+	switch {
+	case tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusPreconditionFailed) && etag == "*":
+		return fmt.Errorf("the object already exists and if_none_match_etag is *, so it wasn't written: %w", err)
+	case tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusPreconditionFailed):
+		return fmt.Errorf("the object's current ETag matches if_none_match_etag (%s), so it wasn't written: %w", etag, err)
+	// Amazon S3 only supports "*", S3-compatible stores may support ETags.
+	case tfawserr.ErrCodeEquals(err, errCodeNotImplemented):
+		return fmt.Errorf("if_none_match_etag (%s) isn't supported by the endpoint, Amazon S3 only supports *: %w", etag, err)
+	}
+
+	return err
+}
+
+// findObjectBucketOwnership returns the object ownership of the specified bucket.
+// A bucket without ownership controls has ObjectWriter ownership, as do buckets created before ownership controls were introduced.
+func findObjectBucketOwnership(ctx context.Context, conn *s3.Client, bucket string, optFns ...func(*s3.Options)) (types.ObjectOwnership, error) {
+	output, err := findOwnershipControls(ctx, conn, bucket, optFns...)
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, errCodeOwnershipControlsNotFoundError):
+		return types.ObjectOwnershipObjectWriter, nil
+	case err != nil:
+		return "", err
+	case len(output.Rules) > 0:
+		return output.Rules[0].ObjectOwnership, nil
+	default:
+		return types.ObjectOwnershipObjectWriter, nil
+	}
+}
+
+// validateObjectACLOwnership returns an error if the specified canned ACL can't be applied to objects in a bucket with the specified object ownership.
+// With BucketOwnerEnforced ownership ACLs are disabled, and only the bucket-owner-full-control canned ACL, which is equivalent to the bucket owner
+// owning the object, is accepted. With BucketOwnerPreferred and ObjectWriter ownership ACLs are enabled.
+func validateObjectACLOwnership(acl string, ownership types.ObjectOwnership) error {
+	if acl == "" || acl == string(types.ObjectCannedACLBucketOwnerFullControl) {
+		return nil
+	}
+
+	if ownership == types.ObjectOwnershipBucketOwnerEnforced {
+		return fmt.Errorf("acl %q can't be applied, the bucket's object ownership is BucketOwnerEnforced, which disables ACLs, remove acl and access_control_policy from the configuration or change the bucket's object ownership", acl)
 	}
 
 	return nil
 }
 
-func hasObjectContentChanges(d verify.ResourceDiffer) bool {
-	for _, key := range []string{
-		"bucket_key_enabled",
-		"cache_control",
-		"checksum_algorithm",
-		"content_base64",
-		"content_disposition",
-		"content_encoding",
-		"content_language",
-		"content_type",
-		"content",
-		"etag",
-		"kms_key_id",
-		"metadata",
-		"server_side_encryption",
-		"source",
-		"source_hash",
-		"storage_class",
-		"website_redirect",
-	} {
-		if d.HasChange(key) {
-			return true
-		}
+// objectAppendOffset returns the offset from which the specified body can be appended to an object of the specified size,
+// i.e. whether the body starts with the object's current content, whose hex-encoded SHA-256 digest is contentSHA256, and is longer.
+// The body is left positioned at the offset, or at its start if it can't be appended.
+func objectAppendOffset(body io.ReadSeeker, size int64, contentSHA256 string) (int64, bool, error) {
+	if size <= 0 || contentSHA256 == "" {
+		return 0, false, nil
 	}
-	return false
+
+	length, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return 0, false, err
+	}
+
+	if length <= size {
+		return 0, false, nil
+	}
+
+	hash := sha256.New()
+	if _, err := io.CopyN(hash, body, size); err != nil {
+		return 0, false, err
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) != contentSHA256 {
+		_, err := body.Seek(0, io.SeekStart)
+		return 0, false, err
+	}
+
+	return size, true, nil
 }
 
-func findObjectByBucketAndKey(ctx context.Context, conn *s3.Client, bucket, key, etag, checksumAlgorithm string, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
-	input := &s3.HeadObjectInput{
+// findObjectRetention returns the retention settings of the specified object version, or of the current version if versionID is empty.
+// An object without retention settings, or in a bucket without Object Lock enabled, isn't found.
+func findObjectRetention(ctx context.Context, conn *s3.Client, bucket, key, versionID string, optFns ...func(*s3.Options)) (*types.ObjectLockRetention, error) {
+	input := &s3.GetObjectRetentionInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
-	if checksumAlgorithm != "" {
-		input.ChecksumMode = types.ChecksumModeEnabled
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
 	}
-	if etag != "" {
-		input.IfMatch = aws.String(etag)
+
+	output, err := conn.GetObjectRetention(ctx, input, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchKey, errCodeNoSuchVersion, errCodeNoSuchObjectLockConfiguration) || tfawserr.ErrMessageContains(err, errCodeInvalidRequest, "Object Lock") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
 	}
 
-	return findObject(ctx, conn, input, optFns...)
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Retention == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Retention, nil
 }
 
-func findObject(ctx context.Context, conn *s3.Client, input *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
-	output, err := conn.HeadObject(ctx, input, optFns...)
+// findObjectLegalHold returns the legal hold status of the specified object version, or of the current version if versionID is empty.
+// An object that has never had a legal hold, or in a bucket without Object Lock enabled, isn't found.
+func findObjectLegalHold(ctx context.Context, conn *s3.Client, bucket, key, versionID string, optFns ...func(*s3.Options)) (*types.ObjectLockLegalHold, error) {
+	input := &s3.GetObjectLegalHoldInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
 
-	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+	output, err := conn.GetObjectLegalHold(ctx, input, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchKey, errCodeNoSuchVersion, errCodeNoSuchObjectLockConfiguration) || tfawserr.ErrMessageContains(err, errCodeInvalidRequest, "Object Lock") {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -697,87 +4064,152 @@ func findObject(ctx context.Context, conn *s3.Client, input *s3.HeadObjectInput,
 		return nil, err
 	}
 
-	if output == nil {
+	if output == nil || output.LegalHold == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output, nil
+	return output.LegalHold, nil
 }
 
-func expandObjectDate(v string) *time.Time {
-	t, err := time.Parse(time.RFC3339, v)
-	if err != nil {
-		return nil
+// findObjectBucketRegion returns the region of the specified bucket or access point.
+// A Multi-Region Access Point doesn't have a region, so its region is empty.
+func findObjectBucketRegion(ctx context.Context, conn *s3.Client, meta interface{}, bucket string, optFns ...func(*s3.Options)) (string, error) {
+	client := meta.(*conns.AWSClient)
+
+	if arn.IsARN(bucket) {
+		v, err := arn.Parse(bucket)
+		if err != nil {
+			return "", err
+		}
+
+		return v.Region, nil
 	}
 
-	return aws.Time(t)
-}
+	// Directory buckets don't support GetBucketLocation, and are accessed in the provider's region.
+	if isDirectoryBucket(bucket) {
+		return client.Region, nil
+	}
 
-func flattenObjectDate(t *time.Time) string {
-	if t == nil {
-		return ""
+	var region string
+	output, err := findBucketLocation(ctx, conn, bucket, "", optFns...)
+
+	switch {
+	case err == nil:
+		region = bucketRegionFromLocationConstraint(output.LocationConstraint)
+	case tfresource.NotFound(err):
+		return "", err
+	default:
+		// GetBucketLocation requires the s3:GetBucketLocation permission, which may not be granted on a bucket in another account.
+		// HeadBucket returns the bucket's region in its response headers even if access to the bucket is denied.
+		log.Printf("[DEBUG] reading S3 Bucket (%s) location: %s", bucket, err)
+
+		region, err = findBucketRegion(ctx, client, bucket, optFns...)
+
+		if err != nil {
+			return "", err
+		}
 	}
 
-	return t.Format(time.RFC3339)
+	return region, nil
 }
 
-// sdkv1CompatibleCleanKey returns an AWS SDK for Go v1 compatible clean key.
-// DisableRestProtocolURICleaning was false on the standard S3Conn, so to ensure backwards
-// compatibility we must "clean" the configured key before passing to AWS SDK for Go v2 APIs.
-// See https://docs.aws.amazon.com/sdk-for-go/api/service/s3/#hdr-Automatic_URI_cleaning.
-// See https://github.com/aws/aws-sdk-go/blob/cf903c8c543034654bb8f53b5f9d6454fdb2117f/private/protocol/rest/build.go#L247-L258.
-func sdkv1CompatibleCleanKey(key string) string {
-	// Remove leading './'.
-	key = strings.TrimPrefix(key, "./")
-	// We are effectively ignoring all leading '/'s and treating multiple '/'s as a single '/'.
-	key = strings.TrimLeft(key, "/")
-	key = regexache.MustCompile(`/+`).ReplaceAllString(key, "/")
-	return key
+const (
+	objectGranteeTypeEmailAddress = "emailAddress"
+	objectGranteeTypeID           = "id"
+	objectGranteeTypeURI          = "uri"
+)
+
+// objectGrantPermissions maps the grant_* attributes to the permissions they grant,
+// which are sent as the corresponding x-amz-grant-* headers, e.g. x-amz-grant-read.
+var objectGrantPermissions = map[string]types.Permission{
+	"grant_full_control": types.PermissionFullControl,
+	"grant_read":         types.PermissionRead,
+	"grant_read_acp":     types.PermissionReadAcp,
+	"grant_write_acp":    types.PermissionWriteAcp,
 }
 
-func ignoreProviderDefaultTags(ctx context.Context, d verify.ResourceDiffer) bool {
-	if v, ok := d.GetOk("override_provider"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		if data := expandOverrideProviderModel(ctx, v.([]interface{})[0].(map[string]interface{})); data != nil && data.DefaultTagsConfig != nil {
-			return len(data.DefaultTagsConfig.Tags) == 0
+// objectGranteeRegexp matches a grantee specified as in the AWS CLI's --grant-* options, e.g. "id=<canonical user ID>".
+var objectGranteeRegexp = regexache.MustCompile(`^(emailAddress|id|uri)=.+$`)
+
+func objectGranteesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeSet,
+		Optional:      true,
+		ConflictsWith: []string{"access_control_policy", "acl"},
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(objectGranteeRegexp, "must be a grantee, e.g. id=<canonical user ID>, uri=<group URI> or emailAddress=<email address>"),
+		},
+	}
+}
+
+func objectGrantAttributes() []string {
+	keys := make([]string, 0, len(objectGrantPermissions))
+	for k := range objectGrantPermissions {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// hasObjectGrants returns whether any grant_* attribute is configured.
+func hasObjectGrants(d *schema.ResourceData) bool {
+	for _, k := range objectGrantAttributes() {
+		if v, ok := d.GetOk(k); ok && v.(*schema.Set).Len() > 0 {
+			return true
 		}
 	}
 
 	return false
 }
 
-type overrideProviderModel struct {
-	DefaultTagsConfig *tftags.DefaultConfig
-}
+// expandObjectGrantees returns the value of the x-amz-grant-* header granting the specified attribute's permission,
+// e.g. `id="111122223333", uri="http://acs.amazonaws.com/groups/global/AuthenticatedUsers"`, or nil if no grantees are configured.
+func expandObjectGrantees(d *schema.ResourceData, key string) *string {
+	grantees := flex.ExpandStringValueSet(d.Get(key).(*schema.Set))
 
-func expandOverrideProviderModel(ctx context.Context, tfMap map[string]interface{}) *overrideProviderModel {
-	if tfMap == nil {
+	if len(grantees) == 0 {
 		return nil
 	}
 
-	data := &overrideProviderModel{}
-
-	if v, ok := tfMap["default_tags"].([]interface{}); ok && len(v) > 0 {
-		if v[0] != nil {
-			data.DefaultTagsConfig = expandDefaultTags(ctx, v[0].(map[string]interface{}))
-		} else {
-			// Ensure that DefaultTagsConfig is not nil as it's checked in ignoreProviderDefaultTags.
-			data.DefaultTagsConfig = expandDefaultTags(ctx, map[string]interface{}{})
-		}
+	slices.Sort(grantees)
+	values := make([]string, 0, len(grantees))
+	for _, grantee := range grantees {
+		typ, value, _ := strings.Cut(grantee, "=")
+		values = append(values, fmt.Sprintf("%s=%q", typ, value))
 	}
 
-	return data
+	return aws.String(strings.Join(values, ", "))
 }
 
-func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
-	if tfMap == nil {
-		return nil
+// flattenObjectGrantees returns the grantees granted the specified permission, in the format of the grant_* attributes.
+// The owner's FULL_CONTROL grant, which S3 may add to an object's ACL, is only returned if it's configured.
+func flattenObjectGrantees(grants []types.Grant, owner *types.Owner, permission types.Permission, configured []string) []string {
+	var grantees []string
+	var ownerGrantee string
+	if owner != nil {
+		ownerGrantee = objectGranteeTypeID + "=" + aws.ToString(owner.ID)
 	}
 
-	data := &tftags.DefaultConfig{}
+	for _, grant := range grants {
+		if grant.Permission != permission || grant.Grantee == nil {
+			continue
+		}
 
-	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
-		data.Tags = tftags.New(ctx, v)
+		switch grantee := grant.Grantee; grantee.Type {
+		case types.TypeCanonicalUser:
+			v := objectGranteeTypeID + "=" + aws.ToString(grantee.ID)
+			if permission == types.PermissionFullControl && v == ownerGrantee && !slices.Contains(configured, v) {
+				continue
+			}
+			grantees = append(grantees, v)
+		case types.TypeGroup:
+			grantees = append(grantees, objectGranteeTypeURI+"="+aws.ToString(grantee.URI))
+		case types.TypeAmazonCustomerByEmail:
+			grantees = append(grantees, objectGranteeTypeEmailAddress+"="+aws.ToString(grantee.EmailAddress))
+		}
 	}
 
-	return data
+	return grantees
 }
@@ -9,15 +9,24 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// newObjectARN returns the ARN of the object with the specified key in the specified bucket name or ARN.
+// Objects accessed via an access point are identified by the access point's object resource ARN,
+// arn:aws:s3:us-west-2:123456789012:accesspoint/name/object/key, as used in IAM and access point policies.
 func newObjectARN(partition string, bucket, key string) (arn.ARN, error) {
 	if arn.IsARN(bucket) {
 		bucketARN, err := arn.Parse(bucket)
 		if err != nil {
 			return arn.ARN{}, fmt.Errorf("S3 Object ARN: unexpected bucket ARN: %s", bucket)
 		}
-		bucketARN.Resource = fmt.Sprintf("%s/%s", bucketARN.Resource, key)
+		if strings.HasPrefix(bucketARN.Resource, "accesspoint/") {
+			bucketARN.Resource = fmt.Sprintf("%s/object/%s", bucketARN.Resource, key)
+		} else {
+			bucketARN.Resource = fmt.Sprintf("%s/%s", bucketARN.Resource, key)
+		}
 		return bucketARN, nil
 	}
 	return arn.ARN{
@@ -27,6 +36,59 @@ func newObjectARN(partition string, bucket, key string) (arn.ARN, error) {
 	}, nil
 }
 
+// newObjectURL returns the URL of the object with the specified key in the specified bucket name or access point ARN.
+// General purpose buckets are addressed using virtual-hosted-style regional URLs, https://bucket.s3.us-west-2.amazonaws.com/key,
+// unless pathStyle is true. Access points and directory buckets don't support path-style requests.
+func newObjectURL(bucket, key, region string, pathStyle bool) string {
+	path := escapeObjectKey(key)
+
+	switch accessPointTypeOf(bucket) {
+	case accessPointTypeStandard, accessPointTypeObjectLambda:
+		apARN, _ := arn.Parse(bucket)
+		name := strings.TrimPrefix(apARN.Resource, "accesspoint/")
+		service := "s3-accesspoint"
+		if apARN.Service == "s3-object-lambda" {
+			service = apARN.Service
+		}
+		return fmt.Sprintf("https://%s-%s.%s.%s.%s/%s", name, apARN.AccountID, service, apARN.Region, names.DNSSuffixForPartition(apARN.Partition), path)
+	case accessPointTypeMultiRegion:
+		apARN, _ := arn.Parse(bucket)
+		alias := strings.TrimPrefix(apARN.Resource, "accesspoint/")
+		return fmt.Sprintf("https://%s.accesspoint.s3-global.%s/%s", alias, names.DNSSuffixForPartition(apARN.Partition), path)
+	}
+
+	dnsSuffix := names.DNSSuffixForPartition(names.PartitionForRegion(region))
+
+	if m := directoryBucketNameRegex.FindStringSubmatch(bucket); m != nil {
+		return fmt.Sprintf("https://%s.s3express-%s.%s.%s/%s", bucket, m[2], region, dnsSuffix, path)
+	}
+
+	if pathStyle {
+		return fmt.Sprintf("https://s3.%s.%s/%s/%s", region, dnsSuffix, bucket, path)
+	}
+
+	return fmt.Sprintf("https://%s/%s", bucketRegionalDomainName(bucket, region), path)
+}
+
+// escapeObjectKey percent-encodes an object key as specified by RFC 3986, keeping "/" separators.
+// Only unreserved characters are left as is. In particular "+" is encoded as %2B and " " as %20,
+// as S3 decodes a literal "+" as a space in some contexts, e.g. the x-amz-copy-source header and website endpoints.
+// Object keys in ARNs and import IDs aren't encoded.
+func escapeObjectKey(key string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(key); i++ {
+		switch c := key[i]; {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '.', c == '_', c == '~', c == '/':
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+
+	return sb.String()
+}
+
 type objectARN struct {
 	arn.ARN
 	Bucket string
@@ -44,7 +106,8 @@ func parseObjectARN(s string) (objectARN, error) {
 	}
 
 	if strings.HasPrefix(arn.Resource, "accesspoint/") {
-		re := regexache.MustCompile(`^(arn:[^:]+:[^:]+:[^:]*:[^:]*:accesspoint/[^/]+)/(.+)$`)
+		// ARNs without the object/ path segment are accepted from state written by earlier versions.
+		re := regexache.MustCompile(`^(arn:[^:]+:[^:]+:[^:]*:[^:]*:accesspoint/[^/]+)/(?:object/)?(.+)$`)
 		m := re.FindStringSubmatch(s)
 		if len(m) == 3 {
 			result.Bucket = m[1]
@@ -62,3 +125,119 @@ func parseObjectARN(s string) (objectARN, error) {
 
 	return result, nil
 }
+
+// accessPointType is the type of S3 access point identified by an access point ARN.
+type accessPointType int
+
+const (
+	accessPointTypeNone accessPointType = iota
+	accessPointTypeStandard
+	accessPointTypeObjectLambda
+	accessPointTypeMultiRegion
+)
+
+// accessPointTypeOf returns the type of access point identified by the specified bucket name or ARN.
+// Requests are routed to the appropriate endpoint by the AWS SDK based on the ARN:
+//
+//	arn:aws:s3:us-west-2:123456789012:accesspoint/name (S3 access point)
+//	arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/name (S3 Object Lambda access point)
+//	arn:aws:s3::123456789012:accesspoint/alias.mrap (Multi-Region access point, SigV4A signed)
+func accessPointTypeOf(bucket string) accessPointType {
+	if !arn.IsARN(bucket) {
+		return accessPointTypeNone
+	}
+
+	bucketARN, err := arn.Parse(bucket)
+	if err != nil {
+		return accessPointTypeNone
+	}
+
+	name, ok := strings.CutPrefix(bucketARN.Resource, "accesspoint/")
+	if !ok || name == "" || strings.Contains(name, "/") {
+		return accessPointTypeNone
+	}
+
+	switch bucketARN.Service {
+	case "s3":
+		if bucketARN.Region == "" {
+			return accessPointTypeMultiRegion
+		}
+		return accessPointTypeStandard
+	case "s3-object-lambda":
+		if bucketARN.Region == "" {
+			return accessPointTypeNone
+		}
+		return accessPointTypeObjectLambda
+	default:
+		return accessPointTypeNone
+	}
+}
+
+// useARNRegion configures an S3 client to send requests via an access point ARN to the ARN's region, rather than failing if
+// it isn't the client's region, e.g. via an access point in an opt-in region such as af-south-1.
+// The ARN must be in the client's partition, see checkObjectBucketPartition.
+func useARNRegion(o *s3.Options) {
+	o.UseARNRegion = true
+}
+
+// checkObjectBucketPartition returns an error if the specified bucket ARN isn't in the specified partition, or if the ARN's region isn't in the ARN's partition.
+// Requests can't be sent across partitions, e.g. from the aws partition via an access point in GovCloud (US), as credentials are partition specific.
+func checkObjectBucketPartition(bucket, partition string) error {
+	if !arn.IsARN(bucket) {
+		return nil
+	}
+
+	bucketARN, err := arn.Parse(bucket)
+	if err != nil {
+		return nil
+	}
+
+	if bucketARN.Partition != partition {
+		return fmt.Errorf("bucket ARN (%s) is in the %s partition, but the provider is configured for the %s partition", bucket, bucketARN.Partition, partition)
+	}
+
+	if region := bucketARN.Region; region != "" {
+		if p := names.PartitionForRegion(region); p != bucketARN.Partition {
+			return fmt.Errorf("bucket ARN (%s) region (%s) is in the %s partition, not the ARN's %s partition", bucket, region, p, bucketARN.Partition)
+		}
+	}
+
+	return nil
+}
+
+// useMultiRegionAccessPoint configures an S3 client to send requests via a Multi-Region Access Point.
+// Requests are signed with SigV4A and sent to the global endpoint, which doesn't support path-style addressing.
+func useMultiRegionAccessPoint(o *s3.Options) {
+	o.DisableMultiRegionAccessPoints = false
+	o.UsePathStyle = false
+}
+
+// validateObjectWriteBucket validates that objects can be written to the specified bucket name or ARN.
+func validateObjectWriteBucket(v interface{}, k string) (ws []string, errors []error) {
+	if err := checkObjectWriteBucket(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+// checkObjectWriteBucket returns an error if objects can't be written to the specified bucket name or ARN.
+// The bucket may not be known until apply time, so this is checked before writing as well as during validation.
+func checkObjectWriteBucket(bucket string) error {
+	// e.g. s3://example-bucket/prefix, as used by the AWS CLI.
+	if strings.HasPrefix(strings.ToLower(bucket), "s3://") {
+		name, prefix, _ := strings.Cut(bucket[len("s3://"):], "/")
+
+		if prefix != "" {
+			return fmt.Errorf("%s is an S3 URI, not a bucket name or ARN, use the bucket name (%s) and prefix key with %s", bucket, name, prefix)
+		}
+
+		return fmt.Errorf("%s is an S3 URI, not a bucket name or ARN, use the bucket name (%s)", bucket, name)
+	}
+
+	if accessPointTypeOf(bucket) == accessPointTypeObjectLambda {
+		return fmt.Errorf("S3 Object Lambda access point (%s) does not support writing objects, use the supporting S3 access point instead", bucket)
+	}
+
+	return nil
+}
@@ -4,9 +4,16 @@
 package s3
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 )
 
 func TestNewObjectARN_GeneralPurposeBucket(t *testing.T) {
@@ -51,7 +58,7 @@ func TestNewObjectARN_AccessPoint(t *testing.T) {
 		Service:   "s3",
 		Region:    "us-west-2", //lintignore:AWSAT003
 		AccountID: "123456789012",
-		Resource:  "accesspoint/test-accesspoint/test-key",
+		Resource:  "accesspoint/test-accesspoint/object/test-key",
 	}
 
 	apARN := arn.ARN{
@@ -77,7 +84,7 @@ func TestNewObjectARN_MultiRegionAccessPoint(t *testing.T) {
 		Partition: "test-partition",
 		Service:   "s3",
 		AccountID: "123456789012",
-		Resource:  "accesspoint/test-multi-region-accesspoint/test-key",
+		Resource:  "accesspoint/test-multi-region-accesspoint/object/test-key",
 	}
 
 	mrapARN := arn.ARN{
@@ -102,7 +109,7 @@ func TestNewObjectARN_ObjectLambdaAccessPoint(t *testing.T) {
 		Partition: "test-partition",
 		Service:   "s3-object-lambda",
 		AccountID: "123456789012",
-		Resource:  "accesspoint/test-object-lambda-accesspoint/test-key",
+		Resource:  "accesspoint/test-object-lambda-accesspoint/object/test-key",
 	}
 
 	olapARN := arn.ARN{
@@ -143,6 +150,35 @@ func TestParseObjectARN_GeneralPurposeBucket(t *testing.T) {
 	equalObjectARN(t, parsed, expectedObjectARN)
 }
 
+func TestParseObjectARN_GeneralPurposeBucket_SpecialCharacters(t *testing.T) {
+	t.Parallel()
+
+	// Object keys in ARNs aren't encoded.
+	for _, key := range []string{"a+b", "a b", "100%/a%20b", "été/😀"} {
+		key := key
+		t.Run(key, func(t *testing.T) {
+			t.Parallel()
+
+			oARN, err := newObjectARN("test-partition", "test-bucket", key)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			parsed, err := parseObjectARN(oARN.String())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := parsed.Bucket, "test-bucket"; got != want {
+				t.Errorf("Bucket = %q, want %q", got, want)
+			}
+			if got, want := parsed.Key, key; got != want {
+				t.Errorf("Key = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func TestParseObjectARN_GeneralPurposeBucket_AccessPointBucketName(t *testing.T) {
 	t.Parallel()
 
@@ -175,7 +211,7 @@ func TestParseObjectARN_AccessPoint(t *testing.T) {
 			Service:   "s3",
 			Region:    "us-west-2", //lintignore:AWSAT003
 			AccountID: "123456789012",
-			Resource:  "accesspoint/test-accesspoint/test-key",
+			Resource:  "accesspoint/test-accesspoint/object/test-key",
 		},
 		Bucket: "arn:test-partition:s3:us-west-2:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003
 		Key:    "test-key",
@@ -207,7 +243,7 @@ func TestParseObjectARN_MultiRegionAccessPoint(t *testing.T) {
 			Partition: "test-partition",
 			Service:   "s3",
 			AccountID: "123456789012",
-			Resource:  "accesspoint/test-multi-region-accesspoint/test-key",
+			Resource:  "accesspoint/test-multi-region-accesspoint/object/test-key",
 		},
 		Bucket: "arn:test-partition:s3::123456789012:accesspoint/test-multi-region-accesspoint",
 		Key:    "test-key",
@@ -238,7 +274,7 @@ func TestParseObjectARN_ObjectLambdaAccessPoint(t *testing.T) {
 			Partition: "test-partition",
 			Service:   "s3-object-lambda",
 			AccountID: "123456789012",
-			Resource:  "accesspoint/test-object-lambda-accesspoint/test-key",
+			Resource:  "accesspoint/test-object-lambda-accesspoint/object/test-key",
 		},
 		Bucket: "arn:test-partition:s3-object-lambda::123456789012:accesspoint/test-object-lambda-accesspoint",
 		Key:    "test-key",
@@ -261,6 +297,381 @@ func TestParseObjectARN_ObjectLambdaAccessPoint(t *testing.T) {
 	equalObjectARN(t, parsed, expectedObjectARN)
 }
 
+func TestParseObjectARN_AccessPointWithoutObjectPath(t *testing.T) {
+	t.Parallel()
+
+	expectedObjectARN := objectARN{
+		ARN: arn.ARN{
+			Partition: "test-partition",
+			Service:   "s3",
+			Region:    "us-west-2", //lintignore:AWSAT003
+			AccountID: "123456789012",
+			Resource:  "accesspoint/test-accesspoint/test-key",
+		},
+		Bucket: "arn:test-partition:s3:us-west-2:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003
+		Key:    "test-key",
+	}
+
+	parsed, err := parseObjectARN("arn:test-partition:s3:us-west-2:123456789012:accesspoint/test-accesspoint/test-key") //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	equalObjectARN(t, parsed, expectedObjectARN)
+}
+
+func TestAccessPointTypeOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bucket   string
+		expected accessPointType
+	}{
+		"bucket name": {
+			bucket:   "test-bucket",
+			expected: accessPointTypeNone,
+		},
+		"bucket name containing accesspoint": {
+			bucket:   "accesspoint",
+			expected: accessPointTypeNone,
+		},
+		"access point": {
+			bucket:   "arn:aws:s3:us-west-2:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+			expected: accessPointTypeStandard,
+		},
+		"Object Lambda access point": {
+			bucket:   "arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/test-object-lambda-accesspoint", //lintignore:AWSAT003,AWSAT005
+			expected: accessPointTypeObjectLambda,
+		},
+		"Object Lambda access point without Region": {
+			bucket:   "arn:aws:s3-object-lambda::123456789012:accesspoint/test-object-lambda-accesspoint", //lintignore:AWSAT005
+			expected: accessPointTypeNone,
+		},
+		"Multi-Region access point": {
+			bucket:   "arn:aws:s3::123456789012:accesspoint/test-multi-region-accesspoint.mrap", //lintignore:AWSAT005
+			expected: accessPointTypeMultiRegion,
+		},
+		"access point object": {
+			bucket:   "arn:aws:s3:us-west-2:123456789012:accesspoint/test-accesspoint/test-key", //lintignore:AWSAT003,AWSAT005
+			expected: accessPointTypeNone,
+		},
+		"Outposts access point": {
+			bucket:   "arn:aws:s3-outposts:us-west-2:123456789012:outpost/op-01234567890123456/accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+			expected: accessPointTypeNone,
+		},
+		"bucket ARN": {
+			bucket:   "arn:aws:s3:::test-bucket", //lintignore:AWSAT005
+			expected: accessPointTypeNone,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := accessPointTypeOf(testCase.bucket), testCase.expected; got != want {
+				t.Errorf("accessPointTypeOf(%q) = %v, want %v", testCase.bucket, got, want)
+			}
+		})
+	}
+}
+
+func TestNewObjectURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bucket    string
+		key       string
+		region    string
+		pathStyle bool
+		expected  string
+	}{
+		"bucket name": {
+			bucket:   "test-bucket",
+			key:      "test-key",
+			region:   "us-west-2",                                               //lintignore:AWSAT003
+			expected: "https://test-bucket.s3.us-west-2.amazonaws.com/test-key", //lintignore:AWSAT003
+		},
+		"key with slashes": {
+			bucket:   "test-bucket",
+			key:      "path/to/index.html",
+			region:   "us-west-2",                                                         //lintignore:AWSAT003
+			expected: "https://test-bucket.s3.us-west-2.amazonaws.com/path/to/index.html", //lintignore:AWSAT003
+		},
+		"key with spaces": {
+			bucket:   "test-bucket",
+			key:      "my folder/my file.txt",
+			region:   "us-west-2",                                                                //lintignore:AWSAT003
+			expected: "https://test-bucket.s3.us-west-2.amazonaws.com/my%20folder/my%20file.txt", //lintignore:AWSAT003
+		},
+		"key with reserved characters": {
+			bucket:   "test-bucket",
+			key:      "a?b#c%d/é",
+			region:   "us-west-2",                                                           //lintignore:AWSAT003
+			expected: "https://test-bucket.s3.us-west-2.amazonaws.com/a%3Fb%23c%25d/%C3%A9", //lintignore:AWSAT003
+		},
+		"key with plus and percent": {
+			bucket:   "test-bucket",
+			key:      "a+b%20c/d=e&f",
+			region:   "us-west-2",                                                            //lintignore:AWSAT003
+			expected: "https://test-bucket.s3.us-west-2.amazonaws.com/a%2Bb%2520c/d%3De%26f", //lintignore:AWSAT003
+		},
+		"key with unicode": {
+			bucket:   "test-bucket",
+			key:      "été/😀.txt",
+			region:   "us-west-2",                                                                     //lintignore:AWSAT003
+			expected: "https://test-bucket.s3.us-west-2.amazonaws.com/%C3%A9t%C3%A9/%F0%9F%98%80.txt", //lintignore:AWSAT003
+		},
+		"path style": {
+			bucket:    "test-bucket",
+			key:       "my folder/my file.txt",
+			region:    "us-west-2", //lintignore:AWSAT003
+			pathStyle: true,
+			expected:  "https://s3.us-west-2.amazonaws.com/test-bucket/my%20folder/my%20file.txt", //lintignore:AWSAT003
+		},
+		"China partition": {
+			bucket:   "test-bucket",
+			key:      "test-key",
+			region:   "cn-north-1",                                                  //lintignore:AWSAT003
+			expected: "https://test-bucket.s3.cn-north-1.amazonaws.com.cn/test-key", //lintignore:AWSAT003
+		},
+		"directory bucket": {
+			bucket:    "test-bucket--usw2-az1--x-s3",
+			key:       "test-key",
+			region:    "us-west-2", //lintignore:AWSAT003
+			pathStyle: true,
+			expected:  "https://test-bucket--usw2-az1--x-s3.s3express-usw2-az1.us-west-2.amazonaws.com/test-key", //lintignore:AWSAT003
+		},
+		"access point": {
+			bucket:    "arn:aws:s3:us-west-2:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+			key:       "my folder/my file.txt",
+			region:    "us-east-1", //lintignore:AWSAT003
+			pathStyle: true,
+			expected:  "https://test-accesspoint-123456789012.s3-accesspoint.us-west-2.amazonaws.com/my%20folder/my%20file.txt", //lintignore:AWSAT003
+		},
+		"Object Lambda access point": {
+			bucket:   "arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/test-object-lambda-accesspoint", //lintignore:AWSAT003,AWSAT005
+			key:      "test-key",
+			region:   "us-west-2",                                                                                             //lintignore:AWSAT003
+			expected: "https://test-object-lambda-accesspoint-123456789012.s3-object-lambda.us-west-2.amazonaws.com/test-key", //lintignore:AWSAT003
+		},
+		"Multi-Region access point": {
+			bucket:   "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap", //lintignore:AWSAT005
+			key:      "test-key",
+			region:   "us-west-2", //lintignore:AWSAT003
+			expected: "https://mfzwi23gnjvgw.mrap.accesspoint.s3-global.amazonaws.com/test-key",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := newObjectURL(testCase.bucket, testCase.key, testCase.region, testCase.pathStyle), testCase.expected; got != want {
+				t.Errorf("newObjectURL(%q, %q) = %s, want %s", testCase.bucket, testCase.key, got, want)
+			}
+		})
+	}
+}
+
+func TestValidateObjectWriteBucket(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bucket        string
+		expectError   bool
+		expectedError string
+	}{
+		"bucket name": {
+			bucket: "test-bucket",
+		},
+		"access point": {
+			bucket: "arn:aws:s3:us-west-2:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+		},
+		"Multi-Region access point": {
+			bucket: "arn:aws:s3::123456789012:accesspoint/test-multi-region-accesspoint.mrap", //lintignore:AWSAT005
+		},
+		"Object Lambda access point": {
+			bucket:        "arn:aws:s3-object-lambda:us-west-2:123456789012:accesspoint/test-object-lambda-accesspoint", //lintignore:AWSAT003,AWSAT005
+			expectError:   true,
+			expectedError: "does not support writing objects",
+		},
+		"directory bucket": {
+			bucket: "test-bucket--usw2-az1--x-s3",
+		},
+		"bucket name containing s3": {
+			bucket: "s3-test-bucket",
+		},
+		"S3 URI": {
+			bucket:        "s3://test-bucket",
+			expectError:   true,
+			expectedError: "use the bucket name (test-bucket)",
+		},
+		"S3 URI with trailing slash": {
+			bucket:        "s3://test-bucket/",
+			expectError:   true,
+			expectedError: "use the bucket name (test-bucket)",
+		},
+		"S3 URI with prefix": {
+			bucket:        "s3://test-bucket/test-prefix/",
+			expectError:   true,
+			expectedError: "use the bucket name (test-bucket) and prefix key with test-prefix/",
+		},
+		"uppercase S3 URI": {
+			bucket:        "S3://test-bucket",
+			expectError:   true,
+			expectedError: "use the bucket name (test-bucket)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validateObjectWriteBucket(testCase.bucket, "bucket")
+
+			if got, want := len(errs) > 0, testCase.expectError; got != want {
+				t.Fatalf("validateObjectWriteBucket(%q) errors = %v, expectError %t", testCase.bucket, errs, want)
+			}
+
+			if testCase.expectError && !strings.Contains(errs[0].Error(), testCase.expectedError) {
+				t.Errorf("validateObjectWriteBucket(%q) error = %s, want %q", testCase.bucket, errs[0], testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestCheckObjectBucketPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		bucket      string
+		partition   string
+		expectError bool
+	}{
+		"bucket name": {
+			bucket:    "test-bucket",
+			partition: "aws-us-gov",
+		},
+		"access point in opt-in region": {
+			bucket:    "arn:aws:s3:af-south-1:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+			partition: "aws",
+		},
+		"GovCloud access point": {
+			bucket:    "arn:aws-us-gov:s3:us-gov-west-1:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+			partition: "aws-us-gov",
+		},
+		"GovCloud access point in aws partition": {
+			bucket:      "arn:aws-us-gov:s3:us-gov-west-1:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+			partition:   "aws",
+			expectError: true,
+		},
+		"GovCloud region in aws partition ARN": {
+			bucket:      "arn:aws:s3:us-gov-west-1:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+			partition:   "aws",
+			expectError: true,
+		},
+		"GovCloud Multi-Region access point": {
+			bucket:    "arn:aws-us-gov:s3::123456789012:accesspoint/test-multi-region-accesspoint.mrap", //lintignore:AWSAT005
+			partition: "aws-us-gov",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkObjectBucketPartition(testCase.bucket, testCase.partition)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("checkObjectBucketPartition(%q, %q) = %v, expectError %t", testCase.bucket, testCase.partition, err, want)
+			}
+		})
+	}
+}
+
+func TestUseARNRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		region       string
+		bucket       string
+		expectedHost string
+	}{
+		"opt-in region": {
+			region:       "us-east-1",                                                       //lintignore:AWSAT003
+			bucket:       "arn:aws:s3:af-south-1:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+			expectedHost: "test-accesspoint-123456789012.s3-accesspoint.af-south-1.amazonaws.com",
+		},
+		"GovCloud": {
+			region:       "us-gov-west-1",                                                             //lintignore:AWSAT003
+			bucket:       "arn:aws-us-gov:s3:us-gov-east-1:123456789012:accesspoint/test-accesspoint", //lintignore:AWSAT003,AWSAT005
+			expectedHost: "test-accesspoint-123456789012.s3-accesspoint.us-gov-east-1.amazonaws.com",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			errStop := errors.New("stop")
+			var host string
+			client := s3.New(s3.Options{
+				Region: testCase.region,
+				APIOptions: []func(*middleware.Stack) error{
+					func(stack *middleware.Stack) error {
+						return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("captureHost", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+							host = in.Request.(*smithyhttp.Request).URL.Host
+							return middleware.FinalizeOutput{}, middleware.Metadata{}, errStop
+						}), middleware.After)
+					},
+				},
+			})
+
+			input := &s3.HeadObjectInput{
+				Bucket: aws.String(testCase.bucket),
+				Key:    aws.String("test-key"),
+			}
+
+			// The ARN's region isn't the client's region.
+			if _, err := client.HeadObject(context.Background(), input); errors.Is(err, errStop) {
+				t.Fatal("expected error without useARNRegion")
+			}
+
+			if _, err := client.HeadObject(context.Background(), input, useARNRegion); !errors.Is(err, errStop) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := host, testCase.expectedHost; got != want {
+				t.Errorf("host = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestUseMultiRegionAccessPoint(t *testing.T) {
+	t.Parallel()
+
+	o := s3.Options{
+		DisableMultiRegionAccessPoints: true,
+		UsePathStyle:                   true,
+	}
+
+	useMultiRegionAccessPoint(&o)
+
+	if o.DisableMultiRegionAccessPoints {
+		t.Errorf("DisableMultiRegionAccessPoints = %t, want false", o.DisableMultiRegionAccessPoints)
+	}
+	if o.UsePathStyle {
+		t.Errorf("UsePathStyle = %t, want false", o.UsePathStyle)
+	}
+}
+
 func equalARN(t *testing.T, a, e arn.ARN) {
 	t.Helper()
 
@@ -8,7 +8,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -51,10 +50,13 @@ func resourceObjectCopy() *schema.Resource {
 				Computed: true,
 			},
 			"bucket": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.NoZeroValues,
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.NoZeroValues,
+					validateObjectWriteBucket,
+				),
 			},
 			"bucket_key_enabled": {
 				Type:     schema.TypeBool,
@@ -329,6 +331,11 @@ func resourceObjectCopy() *schema.Resource {
 
 func resourceObjectCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+
+	if err := checkObjectWriteBucket(d.Get("bucket").(string)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Object Copy: %s", err)
+	}
+
 	return append(diags, resourceObjectCopyDoCopy(ctx, d, meta)...)
 }
 
@@ -476,7 +483,7 @@ func resourceObjectCopyDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	var err error
 	if _, ok := d.GetOk("version_id"); ok {
-		_, err = deleteAllObjectVersions(ctx, conn, bucket, key, d.Get("force_destroy").(bool), false, optFns...)
+		_, err = deleteAllObjectVersions(ctx, conn, bucket, key, d.Get("force_destroy").(bool), false, false, false, optFns...)
 	} else {
 		err = deleteObjectVersion(ctx, conn, bucket, key, "", false, optFns...)
 	}
@@ -503,7 +510,7 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 
 	input := &s3.CopyObjectInput{
 		Bucket:     aws.String(bucket),
-		CopySource: aws.String(url.QueryEscape(d.Get("source").(string))),
+		CopySource: aws.String(escapeObjectKey(d.Get("source").(string))),
 		Key:        aws.String(sdkv1CompatibleCleanKey(d.Get("key").(string))),
 	}
 
@@ -5,6 +5,9 @@ package s3
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"regexp"
 	"strings"
@@ -19,13 +22,24 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	objectBodyDefaultMaxSize = 5 * 1024 * 1024 // 5 MiB
+)
+
+// objectRangeRegexp matches a single HTTP byte range, e.g. "bytes=0-511", "bytes=512-" or "bytes=-16".
+// S3 doesn't support multiple ranges in a single request.
+var objectRangeRegexp = regexache.MustCompile(`^bytes=(\d+-\d*|-\d+)$`)
+
 // @SDKDataSource("aws_s3_object", name="Object")
 func dataSourceObject() *schema.Resource {
 	return &schema.Resource{
@@ -40,6 +54,24 @@ func dataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"body_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"body_content_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsValidRegExp,
+				},
+			},
+			"body_max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      objectBodyDefaultMaxSize,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"bucket": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -89,6 +121,10 @@ func dataSourceObject() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"content_range": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -105,6 +141,28 @@ func dataSourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"if_match": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"if_modified_since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"if_none_match": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"if_unmodified_since": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"is_multipart": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"key": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -118,6 +176,10 @@ func dataSourceObject() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"not_modified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"object_lock_legal_hold_status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -131,8 +193,9 @@ func dataSourceObject() *schema.Resource {
 				Computed: true,
 			},
 			"range": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(objectRangeRegexp, "must be a single byte range, e.g. bytes=0-511"),
 			},
 			"server_side_encryption": {
 				Type:     schema.TypeString,
@@ -182,6 +245,18 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if v, ok := d.GetOk("checksum_mode"); ok {
 		input.ChecksumMode = types.ChecksumMode(v.(string))
 	}
+	if v, ok := d.GetOk("if_match"); ok {
+		input.IfMatch = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("if_modified_since"); ok {
+		input.IfModifiedSince = expandObjectDate(v.(string))
+	}
+	if v, ok := d.GetOk("if_none_match"); ok {
+		input.IfNoneMatch = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("if_unmodified_since"); ok {
+		input.IfUnmodifiedSince = expandObjectDate(v.(string))
+	}
 	if v, ok := d.GetOk("range"); ok {
 		input.Range = aws.String(v.(string))
 	}
@@ -189,8 +264,25 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 		input.VersionId = aws.String(v.(string))
 	}
 
+	id := bucket + "/" + d.Get("key").(string)
+	if v, ok := d.GetOk("version_id"); ok {
+		id += "@" + v.(string)
+	}
+
 	output, err := findObject(ctx, conn, input, optFns...)
 
+	// The object matches if_none_match or hasn't been modified since if_modified_since.
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotModified) {
+		d.SetId(id)
+		d.Set("not_modified", true)
+
+		return diags
+	}
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusPreconditionFailed) {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s): precondition failed, the object doesn't match if_match or has been modified since if_unmodified_since", bucket, key)
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
 	}
@@ -199,10 +291,6 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "S3 Bucket (%s) Object (%s) has been deleted", bucket, key)
 	}
 
-	id := bucket + "/" + d.Get("key").(string)
-	if v, ok := d.GetOk("version_id"); ok {
-		id += "@" + v.(string)
-	}
 	d.SetId(id)
 
 	arn, err := newObjectARN(meta.(*conns.AWSClient).Partition, bucket, key)
@@ -228,17 +316,53 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if output.Expires != nil {
 		d.Set("expires", output.Expires.Format(time.RFC1123))
 	} else {
-		d.Set("expires", nil)
+		// The Expires header isn't a valid HTTP date.
+		d.Set("expires", output.ExpiresString)
 	}
+	// The ETag of a multipart object isn't the MD5 digest of its content.
+	d.Set("is_multipart", objectPartsCount(output) > 0)
 	if output.LastModified != nil {
 		d.Set("last_modified", output.LastModified.Format(time.RFC1123))
 	} else {
 		d.Set("last_modified", nil)
 	}
 	d.Set("metadata", output.Metadata)
+	d.Set("not_modified", false)
 	d.Set("object_lock_legal_hold_status", output.ObjectLockLegalHoldStatus)
 	d.Set("object_lock_mode", output.ObjectLockMode)
 	d.Set("object_lock_retain_until_date", flattenObjectDate(output.ObjectLockRetainUntilDate))
+
+	// HeadObject only returns an object's Object Lock settings if the caller is allowed s3:GetObjectRetention and s3:GetObjectLegalHold,
+	// so they're read separately when missing. Reading an object doesn't otherwise require those permissions, so being denied them isn't an error.
+	// Directory buckets don't support Object Lock.
+	if !isDirectoryBucket(bucket) && output.ObjectLockMode == "" && output.ObjectLockLegalHoldStatus == "" {
+		versionID := aws.ToString(output.VersionId)
+
+		retention, err := findObjectRetention(ctx, conn, bucket, key, versionID, optFns...)
+
+		switch {
+		case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented):
+		case tfawserr.ErrCodeEquals(err, errCodeAccessDenied):
+			log.Printf("[WARN] reading S3 Bucket (%s) Object (%s) retention: %s", bucket, key, err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s) retention: %s", bucket, key, err)
+		default:
+			d.Set("object_lock_mode", retention.Mode)
+			d.Set("object_lock_retain_until_date", flattenObjectDate(retention.RetainUntilDate))
+		}
+
+		legalHold, err := findObjectLegalHold(ctx, conn, bucket, key, versionID, optFns...)
+
+		switch {
+		case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented):
+		case tfawserr.ErrCodeEquals(err, errCodeAccessDenied):
+			log.Printf("[WARN] reading S3 Bucket (%s) Object (%s) legal hold: %s", bucket, key, err)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s) legal hold: %s", bucket, key, err)
+		default:
+			d.Set("object_lock_legal_hold_status", legalHold.Status)
+		}
+	}
 	d.Set("server_side_encryption", output.ServerSideEncryption)
 	d.Set("sse_kms_key_id", output.SSEKMSKeyId)
 	// The "STANDARD" (which is also the default) storage
@@ -250,19 +374,92 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("version_id", output.VersionId)
 	d.Set("website_redirect_location", output.WebsiteRedirectLocation)
 
-	if isContentTypeAllowed(output.ContentType) {
-		downloader := manager.NewDownloader(conn, manager.WithDownloaderClientOptions(optFns...))
-		buf := manager.NewWriteAtBuffer(make([]byte, 0))
-		input := &s3.GetObjectInput{
-			Bucket:    aws.String(bucket),
-			Key:       aws.String(key),
-			VersionId: output.VersionId,
+	var contentTypes []*regexp.Regexp
+	for _, v := range d.Get("body_content_types").(*schema.Set).List() {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "compiling body_content_types pattern (%s): %s", v, err)
+		}
+		contentTypes = append(contentTypes, r)
+	}
+
+	getInput := &s3.GetObjectInput{
+		Bucket:            aws.String(bucket),
+		IfMatch:           input.IfMatch,
+		IfModifiedSince:   input.IfModifiedSince,
+		IfNoneMatch:       input.IfNoneMatch,
+		IfUnmodifiedSince: input.IfUnmodifiedSince,
+		Key:               aws.String(key),
+		VersionId:         output.VersionId,
+	}
+	if v, ok := d.GetOk("range"); ok {
+		getInput.Range = aws.String(v.(string))
+	}
+	maxSize := int64(d.Get("body_max_size").(int))
+
+	if accessPointTypeOf(bucket) == accessPointTypeObjectLambda {
+		// The content returned via an S3 Object Lambda access point is transformed by the access point's Lambda function,
+		// so its length and content type can differ from those of the stored object returned by HeadObject.
+		// It's read in a single request, as the Lambda function may not support the ranged requests made by the download manager.
+		getOutput, err := conn.GetObject(ctx, getInput, optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+		}
+
+		defer getOutput.Body.Close()
+
+		contentType := output.ContentType
+		if getOutput.ContentType != nil {
+			contentType = getOutput.ContentType
+		}
+		readBody := isContentTypeAllowed(contentType, contentTypes...)
+		body, size, err := readObjectBody(getOutput.Body, readBody, maxSize)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s) body: %s", bucket, key, err)
+		}
+
+		d.Set("content_length", size)
+		d.Set("content_range", getOutput.ContentRange)
+		d.Set("content_type", contentType)
+		if readBody {
+			d.Set("body", string(body))
+		}
+	} else if _, ok := d.GetOk("range"); ok {
+		// HeadObject returns the length of the range, so a range larger than body_max_size isn't requested.
+		if err := checkObjectBodySize(aws.ToInt64(output.ContentLength), maxSize); err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s) body: %s", bucket, key, err)
+		}
+
+		getOutput, err := conn.GetObject(ctx, getInput, optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
+		}
+
+		defer getOutput.Body.Close()
+
+		body, _, err := readObjectBody(getOutput.Body, true, maxSize)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s) body: %s", bucket, key, err)
 		}
-		if v, ok := d.GetOk("range"); ok {
-			input.Range = aws.String(v.(string))
+
+		// A range of a binary object is commonly read to inspect e.g. its magic bytes, so its content is returned regardless of the object's content type.
+		// Bytes that aren't valid UTF-8 are only preserved base64-encoded.
+		d.Set("body", string(body))
+		d.Set("body_base64", itypes.Base64Encode(body))
+		d.Set("content_range", getOutput.ContentRange)
+	} else if isContentTypeAllowed(output.ContentType, contentTypes...) {
+		if err := checkObjectBodySize(aws.ToInt64(output.ContentLength), maxSize); err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) Object (%s) body: %s", bucket, key, err)
 		}
 
-		_, err := downloader.Download(ctx, buf, input)
+		downloader := manager.NewDownloader(conn, manager.WithDownloaderClientOptions(optFns...))
+		buf := manager.NewWriteAtBuffer(make([]byte, 0))
+
+		_, err := downloader.Download(ctx, buf, getInput)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "downloading S3 Bucket (%s) Object (%s): %s", bucket, key, err)
@@ -271,7 +468,12 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("body", string(buf.Bytes()))
 	}
 
-	if tags, err := objectListTags(ctx, conn, bucket, key, optFns...); err == nil {
+	// S3 Object Lambda access points don't support GetObjectTagging.
+	if accessPointTypeOf(bucket) == accessPointTypeObjectLambda {
+		return diags
+	}
+
+	if tags, err := objectListTags(ctx, conn, bucket, key, aws.ToString(output.VersionId), optFns...); err == nil {
 		if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 		}
@@ -284,7 +486,8 @@ func dataSourceObjectRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 // This is to prevent potential issues w/ binary files and generally unprintable characters.
 // See https://github.com/hashicorp/terraform/pull/3858#issuecomment-156856738.
-func isContentTypeAllowed(contentType *string) bool {
+// Additional content types can be allowed via patterns.
+func isContentTypeAllowed(contentType *string, patterns ...*regexp.Regexp) bool {
 	if contentType == nil {
 		return false
 	}
@@ -300,6 +503,7 @@ func isContentTypeAllowed(contentType *string) bool {
 		regexache.MustCompile(`^application/xml$`),
 		regexache.MustCompile(`^text/.+`),
 	}
+	allowedContentTypes = append(allowedContentTypes, patterns...)
 	for _, r := range allowedContentTypes {
 		if r.MatchString(aws.ToString(contentType)) {
 			return true
@@ -308,3 +512,49 @@ func isContentTypeAllowed(contentType *string) bool {
 
 	return false
 }
+
+// readObjectBody reads an object's content returned by a single GetObject request, e.g. a range or the transformed content returned via an S3 Object Lambda access point,
+// returning its length and, if readBody is true, its body. Only the first maxSize bytes are kept in memory; a larger body is an error once its length is known.
+func readObjectBody(r io.Reader, readBody bool, maxSize int64) ([]byte, int64, error) {
+	if !readBody {
+		size, err := io.Copy(io.Discard, r)
+
+		return nil, size, err
+	}
+
+	lr := r
+	if maxSize > 0 {
+		lr = io.LimitReader(r, maxSize)
+	}
+
+	body, err := io.ReadAll(lr)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Count any content beyond maxSize.
+	n, err := io.Copy(io.Discard, r)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	size := int64(len(body)) + n
+
+	if err := checkObjectBodySize(size, maxSize); err != nil {
+		return nil, 0, err
+	}
+
+	return body, size, nil
+}
+
+// checkObjectBodySize returns an error if an object body of the specified size is larger than maxSize bytes.
+// A maxSize of 0 disables the check.
+func checkObjectBodySize(size, maxSize int64) error {
+	if maxSize > 0 && size > maxSize {
+		return fmt.Errorf("object size (%d bytes) exceeds body_max_size (%d bytes), increase body_max_size to read the body", size, maxSize)
+	}
+
+	return nil
+}
@@ -5,6 +5,8 @@ package s3_test
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -42,6 +44,7 @@ func TestAccS3ObjectDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "content_length", "11"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "content_type", resourceName, "content_type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_multipart", "false"),
 					resource.TestMatchResourceAttr(dataSourceName, "last_modified", regexache.MustCompile(rfc1123RegexPattern)),
 					resource.TestCheckResourceAttr(dataSourceName, "metadata.%", "0"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "object_lock_legal_hold_status", resourceName, "object_lock_legal_hold_status"),
@@ -77,6 +80,62 @@ func TestAccS3ObjectDataSource_basicViaAccessPoint(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_basicViaObjectLambdaAccessPoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object.test"
+	resourceName := "aws_s3_object.test"
+	accessPointResourceName := "aws_s3control_object_lambda_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_basicViaObjectLambdaAccessPoint(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "bucket", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "body", "HELLO WORLD (transformed)"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", "25"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "key", resourceName, "key"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDataSource_transformedContentViaObjectLambdaAccessPoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object.test"
+	resourceName := "aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_transformedContentViaObjectLambdaAccessPoint(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The stored object's size is that of its untransformed content.
+					resource.TestCheckResourceAttr(resourceName, "content_length", "6"),
+					resource.TestCheckResourceAttr(dataSourceName, "body", "ABCDEF (transformed)"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", "20"),
+				),
+			},
+			{
+				Config:      testAccObjectDataSourceConfig_transformedContentViaObjectLambdaAccessPointMaxSize(rName),
+				ExpectError: regexache.MustCompile(`object size \(20 bytes\) exceeds body_max_size \(10 bytes\)`),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_readableBody(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -111,6 +170,7 @@ func TestAccS3ObjectDataSource_kmsEncrypted(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_s3_object.test"
 	dataSourceName := "data.aws_s3_object.test"
+	kmsKeyResourceName := "aws_kms_key.test"
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
@@ -131,6 +191,9 @@ func TestAccS3ObjectDataSource_kmsEncrypted(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "object_lock_retain_until_date", resourceName, "object_lock_retain_until_date"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "server_side_encryption", resourceName, "server_side_encryption"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "sse_kms_key_id", resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_key_enabled", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption", "aws:kms"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sse_kms_key_id", kmsKeyResourceName, "arn"),
 				),
 			},
 		},
@@ -169,6 +232,31 @@ func TestAccS3ObjectDataSource_bucketKeyEnabled(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_bucketDefaultEncryption(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				// The object is encrypted with the bucket's default encryption, not by its own configuration.
+				Config: testAccObjectDataSourceConfig_bucketDefaultEncryption(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "bucket_key_enabled", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption", "aws:kms"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sse_kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_allParams(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -215,6 +303,61 @@ func TestAccS3ObjectDataSource_allParams(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_systemMetadata(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_systemMetadata(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cache_control", "max-age=3600"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_disposition", "attachment; filename=\"test.txt\""),
+					resource.TestCheckResourceAttr(dataSourceName, "content_encoding", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_language", "en-GB"),
+					// aws_s3_object doesn't support expires, so the object is copied with aws_s3_object_copy.
+					resource.TestMatchResourceAttr(dataSourceName, "expires", regexache.MustCompile(`^Thu, 01 Jan 2099 00:00:00 `)),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption", "AES256"),
+					resource.TestCheckResourceAttr(dataSourceName, "website_redirect_location", "/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDataSource_multipart(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	dataSourceName := "data.aws_s3_object.test"
+	// Larger than the uploader's part size, so the object is uploaded in two parts.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 6*1024*1024/16))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_multipart(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestMatchResourceAttr(dataSourceName, "etag", regexache.MustCompile(`^[0-9a-f]{32}-2$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "is_multipart", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_objectLockLegalHoldOff(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -238,6 +381,9 @@ func TestAccS3ObjectDataSource_objectLockLegalHoldOff(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "object_lock_legal_hold_status", resourceName, "object_lock_legal_hold_status"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "object_lock_mode", resourceName, "object_lock_mode"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "object_lock_retain_until_date", resourceName, "object_lock_retain_until_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_legal_hold_status", "OFF"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_retain_until_date", ""),
 				),
 			},
 		},
@@ -268,6 +414,9 @@ func TestAccS3ObjectDataSource_objectLockLegalHoldOn(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "object_lock_legal_hold_status", resourceName, "object_lock_legal_hold_status"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "object_lock_mode", resourceName, "object_lock_mode"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "object_lock_retain_until_date", resourceName, "object_lock_retain_until_date"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(dataSourceName, "object_lock_retain_until_date", retainUntilDate),
 				),
 			},
 		},
@@ -487,6 +636,159 @@ func TestAccS3ObjectDataSource_checksumMode(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectDataSource_conditional(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_conditional(rName, "if_match", "aws_s3_object.test.etag"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "body", "Hello World"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "not_modified", "false"),
+				),
+			},
+			{
+				Config: testAccObjectDataSourceConfig_conditional(rName, "if_none_match", "aws_s3_object.test.etag"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(dataSourceName, "body"),
+					resource.TestCheckResourceAttr(dataSourceName, "not_modified", "true"),
+				),
+			},
+			{
+				Config: testAccObjectDataSourceConfig_conditional(rName, "if_modified_since", `timeadd(timestamp(), "1h")`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "not_modified", "true"),
+				),
+			},
+			{
+				Config:      testAccObjectDataSourceConfig_conditional(rName, "if_match", `"0123456789abcdef0123456789abcdef"`),
+				ExpectError: regexache.MustCompile(`precondition failed, the object doesn't match if_match`),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDataSource_bodyMaxSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectDataSourceConfig_bodyMaxSize(rName, "text/plain", 1024),
+				ExpectError: regexache.MustCompile(`object size \(2048 bytes\) exceeds body_max_size \(1024 bytes\)`),
+			},
+			{
+				Config: testAccObjectDataSourceConfig_bodyMaxSize(rName, "text/plain", 4096),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "body", resourceName, "content"),
+					resource.TestCheckResourceAttr(dataSourceName, "body_max_size", "4096"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", "2048"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDataSource_bodyMaxSizeBinary(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_bodyMaxSize(rName, "application/octet-stream", 1024),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(dataSourceName, "body"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", "2048"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_type", "application/octet-stream"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDataSource_range(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectDataSourceConfig_range(rName, "bytes=0-15", 8),
+				ExpectError: regexache.MustCompile(`object size \(16 bytes\) exceeds body_max_size \(8 bytes\)`),
+			},
+			{
+				Config: testAccObjectDataSourceConfig_range(rName, "bytes=0-15", 16),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "body", "\x00\x01\x02\x03\x04\x05\x06\x07\x08\x09\x0a\x0b\x0c\x0d\x0e\x0f"),
+					resource.TestCheckResourceAttr(dataSourceName, "body_base64", "AAECAwQFBgcICQoLDA0ODw=="),
+					resource.TestCheckResourceAttr(dataSourceName, "content_length", "16"),
+					resource.TestCheckResourceAttr(dataSourceName, "content_range", "bytes 0-15/32"),
+					resource.TestCheckResourceAttr(dataSourceName, "range", "bytes=0-15"),
+				),
+			},
+			{
+				Config:      testAccObjectDataSourceConfig_range(rName, "bytes=0-15,24-31", 16),
+				ExpectError: regexache.MustCompile(`must be a single byte range`),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDataSource_bodyContentTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDataSourceConfig_bodyContentTypes(rName, `^application/x-yaml$`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "body", "key: value"),
+					resource.TestCheckResourceAttr(dataSourceName, "body_content_types.#", "1"),
+				),
+			},
+			{
+				Config: testAccObjectDataSourceConfig_bodyContentTypes(rName, `^application/x-toml$`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(dataSourceName, "body"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3ObjectDataSource_metadata(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -625,6 +927,113 @@ data "aws_s3_object" "test" {
 `, rName)
 }
 
+func testAccObjectDataSourceConfig_objectLambdaAccessPointBase(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_access_point" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  name   = %[1]q
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = "%[1]s-object-lambda"
+  role = aws_iam_role.iam_for_lambda.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3-object-lambda:WriteGetObjectResponse"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambda_object_transform.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "index.handler"
+  runtime       = "nodejs20.x"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+
+resource "aws_s3control_object_lambda_access_point" "test" {
+  name = %[1]q
+
+  configuration {
+    supporting_access_point = aws_s3_access_point.test.arn
+
+    transformation_configuration {
+      actions = ["GetObject"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn = aws_lambda_function.test.arn
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccObjectDataSourceConfig_basicViaObjectLambdaAccessPoint(rName string) string {
+	return acctest.ConfigCompose(testAccObjectDataSourceConfig_objectLambdaAccessPointBase(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s-key"
+  content = "Hello World"
+
+  tags = {
+    Key1 = "Value1"
+  }
+}
+
+data "aws_s3_object" "test" {
+  bucket = aws_s3control_object_lambda_access_point.test.arn
+  key    = aws_s3_object.test.key
+}
+`, rName))
+}
+
+func testAccObjectDataSourceConfig_transformedContentViaObjectLambdaAccessPoint(rName string) string {
+	return acctest.ConfigCompose(testAccObjectDataSourceConfig_objectLambdaAccessPointBase(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "%[1]s-key"
+  content      = "abcdef"
+  content_type = "application/octet-stream"
+}
+
+data "aws_s3_object" "test" {
+  bucket = aws_s3control_object_lambda_access_point.test.arn
+  key    = aws_s3_object.test.key
+}
+`, rName))
+}
+
+func testAccObjectDataSourceConfig_transformedContentViaObjectLambdaAccessPointMaxSize(rName string) string {
+	return acctest.ConfigCompose(testAccObjectDataSourceConfig_objectLambdaAccessPointBase(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "%[1]s-key"
+  content      = "abcdef"
+  content_type = "application/octet-stream"
+}
+
+data "aws_s3_object" "test" {
+  bucket        = aws_s3control_object_lambda_access_point.test.arn
+  key           = aws_s3_object.test.key
+  body_max_size = 10
+}
+`, rName))
+}
+
 func testAccObjectDataSourceConfig_readableBody(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -671,6 +1080,44 @@ data "aws_s3_object" "test" {
 `, rName)
 }
 
+func testAccObjectDataSourceConfig_bucketDefaultEncryption(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = aws_kms_key.test.arn
+      sse_algorithm     = "aws:kms"
+    }
+    bucket_key_enabled = true
+  }
+}
+
+resource "aws_s3_object" "test" {
+  depends_on = [aws_s3_bucket_server_side_encryption_configuration.test]
+
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s-key"
+  content = "Keep Calm and Carry On"
+}
+
+data "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = aws_s3_object.test.key
+}
+`, rName)
+}
+
 func testAccObjectDataSourceConfig_bucketKeyEnabled(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -741,6 +1188,60 @@ data "aws_s3_object" "test" {
 `, rName)
 }
 
+func testAccObjectDataSourceConfig_systemMetadata(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[1]s-source"
+  content = "Hello, World!"
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "%[1]s-key"
+  source = "${aws_s3_bucket.test.bucket}/${aws_s3_object.test.key}"
+
+  metadata_directive     = "REPLACE"
+  cache_control          = "max-age=3600"
+  content_disposition    = "attachment; filename=\"test.txt\""
+  content_encoding       = "identity"
+  content_language       = "en-GB"
+  content_type           = "text/plain"
+  expires                = "2099-01-01T00:00:00Z"
+  server_side_encryption = "AES256"
+  website_redirect       = "/index.html"
+}
+
+data "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = aws_s3_object_copy.test.key
+}
+`, rName)
+}
+
+func testAccObjectDataSourceConfig_multipart(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "%[1]s-key"
+  source = %[2]q
+}
+
+data "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = aws_s3_object.test.key
+}
+`, rName, source)
+}
+
 func testAccObjectDataSourceConfig_lockLegalHoldOff(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -979,6 +1480,92 @@ data "aws_s3_object" "test" {
 `, rName)
 }
 
+func testAccObjectDataSourceConfig_conditional(rName, condition, value string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "%[1]s-key"
+  content      = "Hello World"
+  content_type = "text/plain"
+}
+
+data "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = aws_s3_object.test.key
+
+  %[2]s = %[3]s
+}
+`, rName, condition, value)
+}
+
+func testAccObjectDataSourceConfig_bodyMaxSize(rName, contentType string, bodyMaxSize int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "%[1]s-key"
+  content      = join("", [for i in range(2048) : "x"])
+  content_type = %[2]q
+}
+
+data "aws_s3_object" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  key           = aws_s3_object.test.key
+  body_max_size = %[3]d
+}
+`, rName, contentType, bodyMaxSize)
+}
+
+func testAccObjectDataSourceConfig_range(rName, byteRange string, bodyMaxSize int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key            = "%[1]s-key"
+  content_base64 = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+  content_type   = "application/octet-stream"
+}
+
+data "aws_s3_object" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  key           = aws_s3_object.test.key
+  range         = %[2]q
+  body_max_size = %[3]d
+}
+`, rName, byteRange, bodyMaxSize)
+}
+
+func testAccObjectDataSourceConfig_bodyContentTypes(rName, pattern string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "%[1]s-key"
+  content      = "key: value"
+  content_type = "application/x-yaml"
+}
+
+data "aws_s3_object" "test" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = aws_s3_object.test.key
+  body_content_types = [%[2]q]
+}
+`, rName, pattern)
+}
+
 func testAccObjectDataSourceConfig_metadata(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
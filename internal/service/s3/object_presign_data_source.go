@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	objectPresignDefaultExpiresIn = 15 * 60          // 15 minutes
+	objectPresignMaxExpiresIn     = 7 * 24 * 60 * 60 // 7 days, the maximum for Signature Version 4
+)
+
+// @SDKDataSource("aws_s3_object_presign", name="Object Presign")
+func dataSourceObjectPresign() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceObjectPresignRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_in": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      objectPresignDefaultExpiresIn,
+				ValidateFunc: validation.IntBetween(1, objectPresignMaxExpiresIn),
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      http.MethodGet,
+				ValidateFunc: validation.StringInSlice([]string{http.MethodGet, http.MethodHead, http.MethodPut}, false),
+			},
+			"presigned_url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"response_content_disposition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"signed_headers": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
+			"sse_customer_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"sse_customer_key"},
+				ValidateFunc: validation.StringInSlice([]string{string(types.ServerSideEncryptionAes256)}, false),
+			},
+			"sse_customer_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"sse_customer_algorithm"},
+				ValidateFunc: validateObjectSSECustomerKey,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectPresignRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	var optFns []func(*s3.Options)
+
+	bucket := d.Get("bucket").(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+	if accessPointTypeOf(bucket) == accessPointTypeMultiRegion {
+		optFns = append(optFns, useMultiRegionAccessPoint)
+	}
+	key := sdkv1CompatibleCleanKey(d.Get("key").(string))
+	method := d.Get("method").(string)
+	expiresIn := time.Duration(d.Get("expires_in").(int)) * time.Second
+
+	if _, ok := d.GetOk("response_content_disposition"); ok && method != http.MethodGet {
+		return sdkdiag.AppendErrorf(diags, "response_content_disposition can only be specified when method is %s", http.MethodGet)
+	}
+	if _, ok := d.GetOk("sse_customer_key"); ok && method != http.MethodPut {
+		return sdkdiag.AppendErrorf(diags, "sse_customer_algorithm and sse_customer_key can only be specified when method is %s", http.MethodPut)
+	}
+	if _, ok := d.GetOk("version_id"); ok && method == http.MethodPut {
+		return sdkdiag.AppendErrorf(diags, "version_id cannot be specified when method is %s", http.MethodPut)
+	}
+
+	presignClient := s3.NewPresignClient(conn, s3.WithPresignClientFromClientOptions(optFns...), s3.WithPresignExpires(expiresIn))
+	expiration := time.Now().Add(expiresIn)
+
+	var output *v4.PresignedHTTPRequest
+	var err error
+
+	switch method {
+	case http.MethodGet:
+		input := &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if v, ok := d.GetOk("response_content_disposition"); ok {
+			input.ResponseContentDisposition = aws.String(v.(string))
+		}
+		if v, ok := d.GetOk("version_id"); ok {
+			input.VersionId = aws.String(v.(string))
+		}
+
+		output, err = presignClient.PresignGetObject(ctx, input)
+	case http.MethodHead:
+		input := &s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if v, ok := d.GetOk("version_id"); ok {
+			input.VersionId = aws.String(v.(string))
+		}
+
+		output, err = presignClient.PresignHeadObject(ctx, input)
+	case http.MethodPut:
+		input := &s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		if v, ok := d.GetOk("sse_customer_key"); ok {
+			input.SSECustomerAlgorithm = aws.String(d.Get("sse_customer_algorithm").(string))
+			input.SSECustomerKey = aws.String(v.(string))
+			input.SSECustomerKeyMD5 = aws.String(objectSSECustomerKeyMD5(v.(string)))
+		}
+
+		output, err = presignClient.PresignPutObject(ctx, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "presigning S3 Bucket (%s) Object (%s) %s request: %s", bucket, key, method, err)
+	}
+
+	d.SetId(bucket + "/" + d.Get("key").(string))
+	d.Set("expiration", expiration.UTC().Format(time.RFC3339))
+	d.Set("presigned_url", output.URL)
+	d.Set("signed_headers", flattenObjectPresignSignedHeaders(output.SignedHeader))
+
+	return diags
+}
+
+// flattenObjectPresignSignedHeaders returns the headers that must be sent with a presigned request.
+// The Host header is implied by the URL.
+func flattenObjectPresignSignedHeaders(apiObject http.Header) map[string]interface{} {
+	tfMap := make(map[string]interface{})
+
+	for k, v := range apiObject {
+		if strings.EqualFold(k, "Host") {
+			continue
+		}
+
+		tfMap[k] = strings.Join(v, ",")
+	}
+
+	return tfMap
+}
+
+// validateObjectSSECustomerKey validates that a value is a base64-encoded 256-bit key.
+func validateObjectSSECustomerKey(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	key, err := base64.StdEncoding.DecodeString(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be base64-encoded: %w", k, err))
+		return
+	}
+
+	if len(key) != 32 {
+		errors = append(errors, fmt.Errorf("%q must be a base64-encoded 256-bit key, got %d bits", k, len(key)*8))
+	}
+
+	return
+}
+
+// objectSSECustomerKeyMD5 returns the base64-encoded MD5 digest of the specified base64-encoded SSE-C key.
+func objectSSECustomerKeyMD5(key string) string {
+	v, _ := base64.StdEncoding.DecodeString(key)
+	digest := md5.Sum(v)
+
+	return base64.StdEncoding.EncodeToString(digest[:])
+}
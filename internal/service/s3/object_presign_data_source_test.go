@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ObjectPresignDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object_presign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectPresignDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "expiration"),
+					resource.TestCheckResourceAttr(dataSourceName, "expires_in", "900"),
+					resource.TestCheckResourceAttr(dataSourceName, "method", "GET"),
+					resource.TestMatchResourceAttr(dataSourceName, "presigned_url", regexache.MustCompile(fmt.Sprintf(`^https://%[1]s\..+/%[1]s-key\?.*X-Amz-Expires=900.*X-Amz-Signature=`, rName))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectPresignDataSource_responseContentDisposition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object_presign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectPresignDataSourceConfig_responseContentDisposition(rName, "GET"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "expires_in", "3600"),
+					resource.TestMatchResourceAttr(dataSourceName, "presigned_url", regexache.MustCompile(`response-content-disposition=attachment%3B%20filename%3D%22test.txt%22`)),
+				),
+			},
+			{
+				Config:      testAccObjectPresignDataSourceConfig_responseContentDisposition(rName, "HEAD"),
+				ExpectError: regexache.MustCompile(`response_content_disposition can only be specified when method is GET`),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectPresignDataSource_putSSECustomerKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object_presign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectPresignDataSourceConfig_sseCustomerKey(rName, "PUT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "method", "PUT"),
+					resource.TestMatchResourceAttr(dataSourceName, "presigned_url", regexache.MustCompile(`X-Amz-SignedHeaders=host%3Bx-amz-server-side-encryption-customer-algorithm%3Bx-amz-server-side-encryption-customer-key%3Bx-amz-server-side-encryption-customer-key-md5`)),
+					resource.TestCheckResourceAttr(dataSourceName, "signed_headers.X-Amz-Server-Side-Encryption-Customer-Algorithm", "AES256"),
+					resource.TestCheckResourceAttr(dataSourceName, "signed_headers.X-Amz-Server-Side-Encryption-Customer-Key", "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="),
+					resource.TestCheckResourceAttr(dataSourceName, "signed_headers.X-Amz-Server-Side-Encryption-Customer-Key-Md5", "hRasmdxgYDKV3nvbahU1MA=="),
+				),
+			},
+			{
+				Config:      testAccObjectPresignDataSourceConfig_sseCustomerKey(rName, "GET"),
+				ExpectError: regexache.MustCompile(`sse_customer_algorithm and sse_customer_key can only be specified when method is PUT`),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectPresignDataSource_head(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_object_presign.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectPresignDataSourceConfig_head(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "method", "HEAD"),
+					resource.TestMatchResourceAttr(dataSourceName, "presigned_url", regexache.MustCompile(`versionId=`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectPresignDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_s3_object_presign" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "%[1]s-key"
+}
+`, rName)
+}
+
+func testAccObjectPresignDataSourceConfig_responseContentDisposition(rName, method string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_s3_object_presign" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key        = "%[1]s-key"
+  method     = %[2]q
+  expires_in = 3600
+
+  response_content_disposition = "attachment; filename=\"test.txt\""
+}
+`, rName, method)
+}
+
+func testAccObjectPresignDataSourceConfig_sseCustomerKey(rName, method string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_s3_object_presign" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "%[1]s-key"
+  method = %[2]q
+
+  sse_customer_algorithm = "AES256"
+  sse_customer_key       = base64encode("0123456789abcdef0123456789abcdef")
+}
+`, rName, method)
+}
+
+func testAccObjectPresignDataSourceConfig_head(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "%[1]s-key"
+  content = "Hello World"
+}
+
+data "aws_s3_object_presign" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key        = aws_s3_object.test.key
+  method     = "HEAD"
+  version_id = aws_s3_object.test.version_id
+}
+`, rName)
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_s3_object_tags", name="Object Tags")
+// @Tags(identifierAttribute="id", resourceType="ObjectTags")
+func resourceObjectTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectTagsCreate,
+		ReadWithoutTimeout:   resourceObjectTagsRead,
+		UpdateWithoutTimeout: resourceObjectTagsUpdate,
+		DeleteWithoutTimeout: resourceObjectTagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObjectTagsImport,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			objectTagsCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.NoZeroValues,
+					validateObjectWriteBucket,
+				),
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceObjectTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get("bucket").(string)
+	conn, optFns := objectTagsConn(ctx, meta, bucket)
+	key := d.Get("key").(string)
+	versionID := d.Get("version_id").(string)
+	id := createObjectTagsResourceID(bucket, key, versionID)
+	key = sdkv1CompatibleCleanKey(key)
+
+	if err := checkObjectWriteBucket(bucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Object Tags (%s): %s", id, err)
+	}
+
+	if _, err := findObjectVersion(ctx, conn, bucket, key, versionID, optFns...); err != nil {
+		if tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "creating S3 Object Tags (%s): S3 Object does not exist", id)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "reading S3 Object (%s): %s", id, err)
+	}
+
+	if err := objectUpdateTags(ctx, conn, bucket, key, versionID, nil, getContextTags(ctx), optFns...); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Object Tags (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceObjectTagsRead(ctx, d, meta)...)
+}
+
+func resourceObjectTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, key, versionID, err := parseObjectTagsResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	conn, optFns := objectTagsConn(ctx, meta, bucket)
+	_, err = findObjectVersion(ctx, conn, bucket, sdkv1CompatibleCleanKey(key), versionID, optFns...)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Object (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Object Tags (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("key", key)
+	d.Set("version_id", versionID)
+
+	return diags
+}
+
+func resourceObjectTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceObjectTagsRead(ctx, d, meta)...)
+}
+
+func resourceObjectTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, key, versionID, err := parseObjectTagsResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Any tags not managed by this resource, e.g. ignored tags, are kept.
+	conn, optFns := objectTagsConn(ctx, meta, bucket)
+	err = objectUpdateTags(ctx, conn, bucket, sdkv1CompatibleCleanKey(key), versionID, d.Get(names.AttrTagsAll), nil, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchKey, errCodeNoSuchVersion) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Object Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceObjectTagsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	bucket, key, versionID, err := parseObjectTagsResourceID(strings.TrimPrefix(d.Id(), "s3://"))
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(createObjectTagsResourceID(bucket, key, versionID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const objectTagsResourceIDVersionSeparator = "?versionId="
+
+// objectTagsAccessPointResourceIDRegexp matches the ID of an aws_s3_object_tags resource whose bucket is an access point ARN,
+// which itself contains a "/", e.g. arn:aws:s3:us-west-2:123456789012:accesspoint/name/key.
+var objectTagsAccessPointResourceIDRegexp = regexache.MustCompile(`^(arn:[^:]+:[^:]+:[^:]*:[^:]*:accesspoint/[^/]+)/(.+)$`)
+
+// createObjectTagsResourceID returns the ID of an aws_s3_object_tags resource, <bucket>/<key>[?versionId=<version-id>].
+func createObjectTagsResourceID(bucket, key, versionID string) string {
+	id := bucket + "/" + key
+	if versionID != "" {
+		id += objectTagsResourceIDVersionSeparator + versionID
+	}
+
+	return id
+}
+
+func parseObjectTagsResourceID(id string) (string, string, string, error) {
+	var versionID string
+	if i := strings.LastIndex(id, objectTagsResourceIDVersionSeparator); i >= 0 {
+		id, versionID = id[:i], id[i+len(objectTagsResourceIDVersionSeparator):]
+	}
+
+	bucket, key, found := strings.Cut(id, "/")
+	if arn.IsARN(id) {
+		bucket, key, found = "", "", false
+		if m := objectTagsAccessPointResourceIDRegexp.FindStringSubmatch(id); m != nil {
+			bucket, key, found = m[1], m[2], true
+		}
+	}
+
+	if !found || bucket == "" || key == "" {
+		return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected <bucket>/<key> or <bucket>/<key>%[2]s<version-id>", id, objectTagsResourceIDVersionSeparator)
+	}
+
+	return bucket, key, versionID, nil
+}
+
+// objectTagsConn returns the S3 API client and client options for requests to the specified bucket,
+// which may be a directory bucket or an access point ARN.
+func objectTagsConn(ctx context.Context, meta interface{}, bucket string) (*s3.Client, []func(*s3.Options)) {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	return conn, objectBucketARNOptFns(bucket)
+}
+
+func findObjectVersion(ctx context.Context, conn *s3.Client, bucket, key, versionID string, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
+
+	return findObject(ctx, conn, input, optFns...)
+}
@@ -0,0 +1,523 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateObjectTags(t *testing.T) {
+	t.Parallel()
+
+	tags := func(n int) map[string]interface{} {
+		m := make(map[string]interface{}, n)
+		for i := 0; i < n; i++ {
+			m[fmt.Sprintf("key%d", i)] = "value"
+		}
+		return m
+	}
+
+	testCases := map[string]struct {
+		tags          map[string]interface{}
+		expectedError string
+	}{
+		"no tags": {
+			tags: map[string]interface{}{},
+		},
+		"max tags": {
+			tags: tags(10),
+		},
+		"too many tags": {
+			tags:          tags(11),
+			expectedError: `an S3 object can have at most 10 tags, got 11`,
+		},
+		"max key length": {
+			tags: map[string]interface{}{strings.Repeat("k", 128): "value"},
+		},
+		"key too long": {
+			tags:          map[string]interface{}{strings.Repeat("k", 129): "value"},
+			expectedError: `is 129 characters long, the maximum is 128`,
+		},
+		"max key length multibyte": {
+			tags: map[string]interface{}{strings.Repeat("é", 128): "value"},
+		},
+		"max value length": {
+			tags: map[string]interface{}{"key": strings.Repeat("v", 256)},
+		},
+		"value too long": {
+			tags:          map[string]interface{}{"key": strings.Repeat("v", 257)},
+			expectedError: `tag "key" value is 257 characters long, the maximum is 256`,
+		},
+		"empty value": {
+			tags: map[string]interface{}{"key": ""},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.ValidateObjectTags(testCase.tags)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q", testCase.expectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("error = %q, want error containing %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestParseObjectTagsResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id                string
+		expectedBucket    string
+		expectedKey       string
+		expectedVersionID string
+		expectedError     bool
+	}{
+		"bucket": {
+			id:             "test-bucket/test/key",
+			expectedBucket: "test-bucket",
+			expectedKey:    "test/key",
+		},
+		"version ID": {
+			id:                "test-bucket/test-key?versionId=abc",
+			expectedBucket:    "test-bucket",
+			expectedKey:       "test-key",
+			expectedVersionID: "abc",
+		},
+		"access point ARN": {
+			id:             "arn:aws:s3:us-west-2:123456789012:accesspoint/test-ap/test/key",
+			expectedBucket: "arn:aws:s3:us-west-2:123456789012:accesspoint/test-ap",
+			expectedKey:    "test/key",
+		},
+		"Multi-Region Access Point ARN": {
+			id:                "arn:aws:s3::123456789012:accesspoint/test.mrap/test-key?versionId=abc",
+			expectedBucket:    "arn:aws:s3::123456789012:accesspoint/test.mrap",
+			expectedKey:       "test-key",
+			expectedVersionID: "abc",
+		},
+		"no key": {
+			id:            "test-bucket",
+			expectedError: true,
+		},
+		"access point ARN no key": {
+			id:            "arn:aws:s3:us-west-2:123456789012:accesspoint/test-ap",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			bucket, key, versionID, err := tfs3.ParseObjectTagsResourceID(testCase.id)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if bucket != testCase.expectedBucket {
+				t.Errorf("bucket = %q, want %q", bucket, testCase.expectedBucket)
+			}
+			if key != testCase.expectedKey {
+				t.Errorf("key = %q, want %q", key, testCase.expectedKey)
+			}
+			if versionID != testCase.expectedVersionID {
+				t.Errorf("versionID = %q, want %q", versionID, testCase.expectedVersionID)
+			}
+		})
+	}
+}
+
+func TestAccS3ObjectTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_tags.test"
+	objectResourceName := "aws_s3_object.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectTagsConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "key", "test-key"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", ""),
+					testAccCheckObjectCheckTags(ctx, objectResourceName, map[string]string{
+						"key1": "value1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccObjectTagsConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					testAccCheckObjectCheckTags(ctx, objectResourceName, map[string]string{
+						"key1": "value1updated",
+						"key2": "value2",
+					}),
+				),
+			},
+			{
+				Config: testAccObjectTagsConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					testAccCheckObjectCheckTags(ctx, objectResourceName, map[string]string{
+						"key2": "value2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectTags_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectTagsConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectTagsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceObject(), "aws_s3_object.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectTags_objectDoesNotExist(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectTagsConfig_objectDoesNotExist(rName),
+				ExpectError: regexache.MustCompile(`S3 Object does not exist`),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectTags_limits(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectTagsConfig_tags1(rName, "key1", strings.Repeat("v", 257)),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`tag "key1" value is 257 characters long, the maximum is 256`),
+			},
+			{
+				Config:      testAccObjectTagsConfig_count(rName, 11),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`an S3 object can have at most 10 tags, got 11`),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectTags_versionID(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_tags.test"
+	objectResourceName := "aws_s3_object.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectTagsConfig_versionID(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectTagsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "version_id", objectResourceName, "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectTags_defaultAndIgnoreTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_tags.test"
+	objectResourceName := "aws_s3_object.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("providerkey1", "providervalue1", "ignorekey"),
+					testAccObjectTagsConfig_tags1(rName, "key1", "value1"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectTagsExists(ctx, resourceName),
+					testAccCheckObjectUpdateTags(ctx, objectResourceName, nil, map[string]string{"ignorekey1": "ignorevalue1"}),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("providerkey1", "providervalue1", "ignorekey"),
+					testAccObjectTagsConfig_tags1(rName, "key1", "value1"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					testAccCheckObjectCheckTags(ctx, objectResourceName, map[string]string{
+						"ignorekey1":   "ignorevalue1",
+						"key1":         "value1",
+						"providerkey1": "providervalue1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectTagsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_object_tags" {
+				continue
+			}
+
+			_, err := tfs3.FindObjectVersion(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), rs.Primary.Attributes["version_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			tags, err := tfs3.ObjectListTags(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), rs.Primary.Attributes["version_id"])
+
+			if err != nil {
+				return err
+			}
+
+			if len(tags) > 0 {
+				return fmt.Errorf("S3 Object Tags %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectTagsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectVersion(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), rs.Primary.Attributes["version_id"])
+
+		return err
+	}
+}
+
+func testAccObjectTagsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "stuff"
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+`, rName)
+}
+
+func testAccObjectTagsConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccObjectTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object_tags" "test" {
+  bucket = aws_s3_object.test.bucket
+  key    = aws_s3_object.test.key
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccObjectTagsConfig_count(rName string, n int) string {
+	return acctest.ConfigCompose(testAccObjectTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object_tags" "test" {
+  bucket = aws_s3_object.test.bucket
+  key    = aws_s3_object.test.key
+
+  tags = { for i in range(%[1]d) : "key${i}" => "value${i}" }
+}
+`, n))
+}
+
+func testAccObjectTagsConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccObjectTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object_tags" "test" {
+  bucket = aws_s3_object.test.bucket
+  key    = aws_s3_object.test.key
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccObjectTagsConfig_objectDoesNotExist(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object_tags" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "does-not-exist"
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, rName)
+}
+
+func testAccObjectTagsConfig_versionID(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "test-key"
+  content = "stuff"
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_s3_object_tags" "test" {
+  bucket     = aws_s3_object.test.bucket
+  key        = aws_s3_object.test.key
+  version_id = aws_s3_object.test.version_id
+
+  tags = {
+    key1 = "value1"
+  }
+}
+`, rName)
+}
@@ -5,25 +5,41 @@ package s3_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"runtime"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
@@ -107,2354 +123,8750 @@ func TestSDKv1CompatibleCleanKey(t *testing.T) {
 	}
 }
 
-func TestAccS3Object_basic(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestTagsFromKeyPattern(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, ""),
-					resource.TestCheckNoResourceAttr(resourceName, "acl"),
-					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", fmt.Sprintf("%s/test-key", rName)),
-					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
-					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "cache_control", ""),
-					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
-					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
-					resource.TestCheckNoResourceAttr(resourceName, "content"),
-					resource.TestCheckNoResourceAttr(resourceName, "content_base64"),
-					resource.TestCheckResourceAttr(resourceName, "content_disposition", ""),
-					resource.TestCheckResourceAttr(resourceName, "content_encoding", ""),
-					resource.TestCheckResourceAttr(resourceName, "content_language", ""),
-					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
-					resource.TestCheckResourceAttrSet(resourceName, "etag"),
-					resource.TestCheckResourceAttr(resourceName, "force_destroy", "false"),
-					resource.TestCheckResourceAttr(resourceName, "key", "test-key"),
-					resource.TestCheckNoResourceAttr(resourceName, "kms_key_id"),
-					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", "AES256"),
-					resource.TestCheckNoResourceAttr(resourceName, "source"),
-					resource.TestCheckNoResourceAttr(resourceName, "source_hash"),
-					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "version_id", ""),
-					resource.TestCheckResourceAttr(resourceName, "website_redirect", ""),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
+	testCases := []struct {
+		name          string
+		pattern       string
+		tags          map[string]interface{}
+		key           string
+		want          map[string]string
+		expectedError bool
+	}{
+		{
+			name:    "numbered submatch",
+			pattern: `^tenants/([^/]+)/`,
+			tags:    map[string]interface{}{"Tenant": "$1"},
+			key:     "tenants/acme/reports/2024.csv",
+			want:    map[string]string{"Tenant": "acme"},
 		},
-	})
-}
+		{
+			name:    "named submatch",
+			pattern: `^(?P<env>[^/]+)/(?P<app>[^/]+)/`,
+			tags:    map[string]interface{}{"Environment": "${env}", "Application": "app-${app}"},
+			key:     "prod/web/index.html",
+			want:    map[string]string{"Environment": "prod", "Application": "app-web"},
+		},
+		{
+			name:    "literal value",
+			pattern: `\.csv$`,
+			tags:    map[string]interface{}{"Format": "csv"},
+			key:     "tenants/acme/reports/2024.csv",
+			want:    map[string]string{"Format": "csv"},
+		},
+		{
+			name:    "unmatched submatch",
+			pattern: `^tenants/([^/]+)/(archive/)?`,
+			tags:    map[string]interface{}{"Archive": "$2"},
+			key:     "tenants/acme/reports/2024.csv",
+			want:    map[string]string{"Archive": ""},
+		},
+		{
+			name:          "no match",
+			pattern:       `^tenants/([^/]+)/`,
+			tags:          map[string]interface{}{"Tenant": "$1"},
+			key:           "reports/2024.csv",
+			expectedError: true,
+		},
+		{
+			name:          "invalid pattern",
+			pattern:       `^tenants/([^/]+/`,
+			tags:          map[string]interface{}{"Tenant": "$1"},
+			key:           "tenants/acme/reports/2024.csv",
+			expectedError: true,
+		},
+	}
 
-func TestAccS3Object_disappears(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := acctest.Context(t)
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceObject(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
-		},
-	})
+			tfMap := map[string]interface{}{
+				"pattern": testCase.pattern,
+				"tags":    testCase.tags,
+			}
+			got, err := tfs3.TagsFromKeyPattern(ctx, tfMap, testCase.key)
+
+			if err == nil && testCase.expectedError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.expectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got.Map(), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_Disappears_bucket(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestObjectPartsCount(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucket(), "aws_s3_bucket.test"),
-				),
-				ExpectNonEmptyPlan: true,
-			},
+	testCases := []struct {
+		name   string
+		output *s3.HeadObjectOutput
+		want   int32
+	}{
+		{
+			name:   "single part",
+			output: &s3.HeadObjectOutput{ETag: aws.String(`"d41d8cd98f00b204e9800998ecf8427e"`)},
+			want:   0,
 		},
-	})
+		{
+			name:   "multipart",
+			output: &s3.HeadObjectOutput{ETag: aws.String(`"9b2cf535f27731c974343645a3985328-3"`)},
+			want:   3,
+		},
+		{
+			name:   "parts count",
+			output: &s3.HeadObjectOutput{ETag: aws.String(`"9b2cf535f27731c974343645a3985328-3"`), PartsCount: aws.Int32(3)},
+			want:   3,
+		},
+		{
+			name:   "no ETag",
+			output: &s3.HeadObjectOutput{},
+			want:   0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfs3.ObjectPartsCount(testCase.output); got != testCase.want {
+				t.Errorf("ObjectPartsCount() = %d, want %d", got, testCase.want)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_upgradeFromV4(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestDetectObjectContentType(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:   acctest.ErrorCheck(t, names.S3ServiceID),
-		CheckDestroy: testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				ExternalProviders: map[string]resource.ExternalProvider{
-					"aws": {
-						Source:            "hashicorp/aws",
-						VersionConstraint: "4.67.0",
-					},
-				},
-				Config: testAccObjectConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-				),
-			},
-			{
-				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-				Config:                   testAccObjectConfig_basic(rName),
-				PlanOnly:                 true,
-			},
+	testCases := []struct {
+		name          string
+		key           string
+		content       string
+		contentBase64 string
+		want          string
+	}{
+		{
+			name:    "extension",
+			key:     "test-key.json",
+			content: "<html><body></body></html>",
+			want:    "application/json",
 		},
-	})
+		{
+			name:    "content",
+			key:     "test-key",
+			content: "<html><body></body></html>",
+			want:    "text/html; charset=utf-8",
+		},
+		{
+			name:          "content_base64",
+			key:           "test-key",
+			contentBase64: "iVBORw0KGgo=",
+			want:          "image/png",
+		},
+		{
+			name: "empty",
+			key:  "test-key",
+			want: "text/plain; charset=utf-8",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.DetectObjectContentType(testCase.key, "", testCase.content, testCase.contentBase64)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("DetectObjectContentType() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_source(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestValidateObjectBodySource(t *testing.T) {
+	t.Parallel()
 
-	source := testAccObjectCreateTempFile(t, "{anything will do }")
-	defer os.Remove(source)
+	config := func(content, contentBase64, source, sourceBucket cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"content":        content,
+			"content_base64": contentBase64,
+			"content_secret": cty.NullVal(cty.String),
+			"source":         source,
+			"source_bucket":  sourceBucket,
+		})
+	}
+	null := cty.NullVal(cty.String)
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_source(rName, source),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "{anything will do }"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "source"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
+	testCases := []struct {
+		name        string
+		config      cty.Value
+		expectError string
+	}{
+		{
+			name:   "none",
+			config: config(null, null, null, null),
 		},
-	})
-}
+		{
+			name:   "content",
+			config: config(cty.StringVal("test"), null, null, null),
+		},
+		{
+			name:   "source",
+			config: config(null, null, cty.StringVal("test-fixtures/test.txt"), null),
+		},
+		{
+			name:        "content and source",
+			config:      config(cty.StringVal("test"), null, cty.StringVal("test-fixtures/test.txt"), null),
+			expectError: "only one of content, content_base64, content_secret, source, source_bucket can be specified, but content, source are configured",
+		},
+		{
+			name:        "unknown content_base64 and source",
+			config:      config(null, cty.UnknownVal(cty.String), cty.StringVal("test-fixtures/test.txt"), null),
+			expectError: "only one of content, content_base64, content_secret, source, source_bucket can be specified, but content_base64, source are configured",
+		},
+		{
+			name:        "empty content and source_bucket",
+			config:      config(cty.StringVal(""), null, null, cty.StringVal("test-bucket")),
+			expectError: "only one of content, content_base64, content_secret, source, source_bucket can be specified, but content, source_bucket are configured",
+		},
+	}
 
-func TestAccS3Object_content(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_content(rName, "some_bucket_content"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "some_bucket_content"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"content", "content_base64", "force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
-		},
-	})
-}
+			err := tfs3.ValidateObjectBodySource(testCase.config)
 
-func TestAccS3Object_etagEncryption(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	source := testAccObjectCreateTempFile(t, "{anything will do }")
-	defer os.Remove(source)
+			if testCase.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_etagEncryption(rName, source),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "{anything will do }"),
-					resource.TestCheckResourceAttr(resourceName, "etag", "7b006ff4d70f68cc65061acf2f802e6f"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "source"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
-		},
-	})
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectError)
+			}
+
+			if got := err.Error(); got != testCase.expectError {
+				t.Errorf("error = %q, want %q", got, testCase.expectError)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_contentBase64(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestValidateObjectChecksumType(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_contentBase64(rName, base64.StdEncoding.EncodeToString([]byte("some_bucket_content"))),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "some_bucket_content"),
-				),
-			},
-		},
-	})
-}
+	testCases := []struct {
+		algorithm    types.ChecksumAlgorithm
+		checksumType types.ChecksumType
+		expectError  string
+	}{
+		{algorithm: types.ChecksumAlgorithmCrc32, checksumType: types.ChecksumTypeComposite},
+		{algorithm: types.ChecksumAlgorithmCrc32, checksumType: types.ChecksumTypeFullObject},
+		{algorithm: types.ChecksumAlgorithmCrc32c, checksumType: types.ChecksumTypeComposite},
+		{algorithm: types.ChecksumAlgorithmCrc32c, checksumType: types.ChecksumTypeFullObject},
+		{algorithm: types.ChecksumAlgorithmCrc64nvme, checksumType: types.ChecksumTypeComposite, expectError: "checksum_type COMPOSITE is not supported with checksum_algorithm CRC64NVME"},
+		{algorithm: types.ChecksumAlgorithmCrc64nvme, checksumType: types.ChecksumTypeFullObject},
+		{algorithm: types.ChecksumAlgorithmSha1, checksumType: types.ChecksumTypeComposite},
+		{algorithm: types.ChecksumAlgorithmSha1, checksumType: types.ChecksumTypeFullObject, expectError: "checksum_type FULL_OBJECT is not supported with checksum_algorithm SHA1"},
+		{algorithm: types.ChecksumAlgorithmSha256, checksumType: types.ChecksumTypeComposite},
+		{algorithm: types.ChecksumAlgorithmSha256, checksumType: types.ChecksumTypeFullObject, expectError: "checksum_type FULL_OBJECT is not supported with checksum_algorithm SHA256"},
+		{checksumType: types.ChecksumTypeComposite, expectError: "checksum_type requires checksum_algorithm to be set"},
+	}
 
-func TestAccS3Object_sourceHashTrigger(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj, updated_obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%s %s", testCase.algorithm, testCase.checksumType), func(t *testing.T) {
+			t.Parallel()
 
-	startingData := "Ebben!"
-	changingData := "Ne andrò lontana"
+			err := tfs3.ValidateObjectChecksumType(testCase.algorithm, testCase.checksumType)
 
-	filename := testAccObjectCreateTempFile(t, startingData)
-	defer os.Remove(filename)
+			if testCase.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
 
-	rewriteFile := func(*terraform.State) error {
-		if err := os.WriteFile(filename, []byte(changingData), 0644); err != nil {
-			os.Remove(filename)
-			t.Fatal(err)
-		}
-		return nil
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expectError)
+			}
+
+			if got := err.Error(); got != testCase.expectError {
+				t.Errorf("error = %q, want %q", got, testCase.expectError)
+			}
+		})
 	}
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_sourceHashTrigger(rName, filename),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "Ebben!"),
-					resource.TestCheckResourceAttr(resourceName, "source_hash", "7c7e02a79f28968882bb1426c8f8bfc6"),
-					rewriteFile,
-				),
-				ExpectNonEmptyPlan: true,
-			},
-			{
-				Config: testAccObjectConfig_sourceHashTrigger(rName, filename),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &updated_obj),
-					testAccCheckObjectBody(&updated_obj, "Ne andrò lontana"),
-					resource.TestCheckResourceAttr(resourceName, "source_hash", "cffc5e20de2d21764145b1124c9b337b"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"content", "content_base64", "force_destroy", "source", "source_hash"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
+func TestValidateObjectContentUTF8(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content     string
+		expectError bool
+	}{
+		"empty": {
+			content: "",
 		},
-	})
+		"ascii": {
+			content: "Hello World",
+		},
+		"multibyte": {
+			content: "Grüße, 世界",
+		},
+		"invalid byte": {
+			content:     "Hello \xff World",
+			expectError: true,
+		},
+		"truncated sequence": {
+			content:     "Hello \xe4\xb8",
+			expectError: true,
+		},
+		"replacement character": {
+			content:     "\ufffdPNG\r\n\u001a\n",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.ValidateObjectContentUTF8(testCase.content)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, want error: %t", err, want)
+			}
+			if err != nil && !strings.Contains(err.Error(), "content_base64") {
+				t.Errorf("expected error to mention content_base64, got %q", err)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_withContentCharacteristics(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestCheckObjectKeyNormalization(t *testing.T) {
+	t.Parallel()
 
-	source := testAccObjectCreateTempFile(t, "{anything will do }")
-	defer os.Remove(source)
+	testCases := []struct {
+		key           string
+		expectError   bool
+		expectWarning bool
+	}{
+		{key: "test-key"},
+		{key: "first/second/third/"},
+		{key: "test-key/"},
+		{key: "/test-key", expectWarning: true},
+		{key: "/////test-key", expectWarning: true},
+		{key: "./test-key", expectWarning: true},
+		{key: "first//second///third//", expectWarning: true},
+		{key: "/", expectError: true},
+		{key: "./", expectError: true},
+		{key: "///", expectError: true},
+	}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_contentCharacteristics(rName, source),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "{anything will do }"),
-					resource.TestCheckResourceAttr(resourceName, "content_type", "binary/octet-stream"),
-					resource.TestCheckResourceAttr(resourceName, "website_redirect", "http://google.com"),
-				),
-			},
-		},
-	})
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.key, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tfs3.CheckObjectKeyNormalization(testCase.key)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Fatalf("error = %t, want %t: %v", got, want, diags)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			if got, want := len(diags) > 0, testCase.expectWarning; got != want {
+				t.Errorf("warning = %t, want %t", got, want)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_nonVersioned(t *testing.T) {
-	ctx := acctest.Context(t)
-	sourceInitial := testAccObjectCreateTempFile(t, "initial object state")
-	defer os.Remove(sourceInitial)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	var originalObj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
+func TestParseObjectImportID(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAssumeRoleARN(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_nonVersioned(rName, sourceInitial),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &originalObj),
-					testAccCheckObjectBody(&originalObj, "initial object state"),
-					resource.TestCheckResourceAttr(resourceName, "version_id", ""),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "source"},
-				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
-			},
-		},
-	})
+	testCases := []struct {
+		id             string
+		expectedBucket string
+		expectedKey    string
+		expectError    bool
+	}{
+		{id: "test-bucket/test-key", expectedBucket: "test-bucket", expectedKey: "test-key"},
+		{id: "s3://test-bucket/test-key", expectedBucket: "test-bucket", expectedKey: "test-key"},
+		{id: "test-bucket/first/second/test-key", expectedBucket: "test-bucket", expectedKey: "first/second/test-key"},
+		{id: "test-bucket/folder/", expectedBucket: "test-bucket", expectedKey: "folder/"},
+		{id: "s3://test-bucket/first/folder/", expectedBucket: "test-bucket", expectedKey: "first/folder/"},
+		{id: "test-bucket/a+b", expectedBucket: "test-bucket", expectedKey: "a+b"},
+		{id: "test-bucket/a b/c%20d", expectedBucket: "test-bucket", expectedKey: "a b/c%20d"},
+		{id: "s3://test-bucket/été/😀", expectedBucket: "test-bucket", expectedKey: "été/😀"},
+		{id: "test-bucket", expectError: true},
+		{id: "test-bucket/", expectError: true},
+		{id: "s3://test-bucket//", expectError: true},
+		{id: "/test-key", expectError: true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.id, func(t *testing.T) {
+			t.Parallel()
+
+			bucket, key, err := tfs3.ParseObjectImportID(testCase.id)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, want error: %t", err, want)
+			}
+			if got, want := bucket, testCase.expectedBucket; got != want {
+				t.Errorf("bucket = %q, want %q", got, want)
+			}
+			if got, want := key, testCase.expectedKey; got != want {
+				t.Errorf("key = %q, want %q", got, want)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_updates(t *testing.T) {
-	ctx := acctest.Context(t)
-	var originalObj, modifiedObj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestValidateObjectMetadataHTTPHeaders(t *testing.T) {
+	t.Parallel()
 
-	sourceInitial := testAccObjectCreateTempFile(t, "initial object state")
-	defer os.Remove(sourceInitial)
-	sourceModified := testAccObjectCreateTempFile(t, "modified object")
-	defer os.Remove(sourceInitial)
+	testCases := []struct {
+		key         string
+		expectError string
+	}{
+		{key: "key1"},
+		{key: "content-type-override"},
+		{key: "x-amz-meta-content-type"},
+		{key: "cache-control", expectError: `metadata key "cache-control" is a reserved HTTP header, use the cache_control argument instead`},
+		{key: "content-disposition", expectError: `metadata key "content-disposition" is a reserved HTTP header, use the content_disposition argument instead`},
+		{key: "content-encoding", expectError: `metadata key "content-encoding" is a reserved HTTP header, use the content_encoding argument instead`},
+		{key: "content-language", expectError: `metadata key "content-language" is a reserved HTTP header, use the content_language argument instead`},
+		{key: "content-length", expectError: `metadata key "content-length" is a reserved HTTP header and cannot be specified`},
+		{key: "content-md5", expectError: `metadata key "content-md5" is a reserved HTTP header and cannot be specified`},
+		{key: "content-type", expectError: `metadata key "content-type" is a reserved HTTP header, use the content_type argument instead`},
+		{key: "Content-Type", expectError: `metadata key "Content-Type" is a reserved HTTP header, use the content_type argument instead`},
+		{key: "expires", expectError: `metadata key "expires" is a reserved HTTP header, use the expires argument instead`},
+		{key: "x-amz-object-lock-legal-hold", expectError: `metadata key "x-amz-object-lock-legal-hold" is a reserved HTTP header, use the object_lock_legal_hold_status argument instead`},
+		{key: "x-amz-object-lock-mode", expectError: `metadata key "x-amz-object-lock-mode" is a reserved HTTP header, use the object_lock_mode argument instead`},
+		{key: "x-amz-object-lock-retain-until-date", expectError: `metadata key "x-amz-object-lock-retain-until-date" is a reserved HTTP header, use the object_lock_retain_until_date argument instead`},
+		{key: "x-amz-server-side-encryption", expectError: `metadata key "x-amz-server-side-encryption" is a reserved HTTP header, use the server_side_encryption argument instead`},
+		{key: "x-amz-storage-class", expectError: `metadata key "x-amz-storage-class" is a reserved HTTP header, use the storage_class argument instead`},
+		{key: "x-amz-website-redirect-location", expectError: `metadata key "x-amz-website-redirect-location" is a reserved HTTP header, use the website_redirect argument instead`},
+	}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_updateable(rName, false, sourceInitial),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &originalObj),
-					testAccCheckObjectBody(&originalObj, "initial object state"),
-					resource.TestCheckResourceAttr(resourceName, "etag", "647d1d58e1011c743ec67d5e8af87b53"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updateable(rName, false, sourceModified),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &modifiedObj),
-					testAccCheckObjectBody(&modifiedObj, "modified object"),
-					resource.TestCheckResourceAttr(resourceName, "etag", "1c7fd13df1515c2a13ad9eb068931f09"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "source"},
-				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
-			},
-		},
-	})
-}
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.key, func(t *testing.T) {
+			t.Parallel()
 
-func TestAccS3Object_updateSameFile(t *testing.T) {
-	ctx := acctest.Context(t)
-	var originalObj, modifiedObj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+			_, errs := tfs3.ValidateObjectMetadataHTTPHeaders(map[string]interface{}{testCase.key: "value"}, "metadata")
 
-	startingData := "lane 8"
-	changingData := "chicane"
+			if testCase.expectError == "" {
+				if len(errs) != 0 {
+					t.Fatalf("unexpected errors: %v", errs)
+				}
+				return
+			}
 
-	filename := testAccObjectCreateTempFile(t, startingData)
-	defer os.Remove(filename)
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+			}
 
-	rewriteFile := func(*terraform.State) error {
-		if err := os.WriteFile(filename, []byte(changingData), 0644); err != nil {
-			os.Remove(filename)
-			t.Fatal(err)
-		}
-		return nil
+			if got := errs[0].Error(); got != testCase.expectError {
+				t.Errorf("error = %q, want %q", got, testCase.expectError)
+			}
+		})
 	}
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_updateable(rName, false, filename),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &originalObj),
-					testAccCheckObjectBody(&originalObj, startingData),
-					resource.TestCheckResourceAttr(resourceName, "etag", "aa48b42f36a2652cbee40c30a5df7d25"),
-					rewriteFile,
-				),
-				ExpectNonEmptyPlan: true,
-			},
-			{
-				Config: testAccObjectConfig_updateable(rName, false, filename),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &modifiedObj),
-					testAccCheckObjectBody(&modifiedObj, changingData),
-					resource.TestCheckResourceAttr(resourceName, "etag", "fafc05f8c4da0266a99154681ab86e8c"),
-				),
-			},
-		},
-	})
 }
 
-func TestAccS3Object_updatesWithVersioning(t *testing.T) {
-	ctx := acctest.Context(t)
-	var originalObj, modifiedObj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestCheckObjectComplianceRetention(t *testing.T) {
+	t.Parallel()
 
-	sourceInitial := testAccObjectCreateTempFile(t, "initial versioned object state")
-	defer os.Remove(sourceInitial)
-	sourceModified := testAccObjectCreateTempFile(t, "modified versioned object")
-	defer os.Remove(sourceInitial)
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_updateable(rName, true, sourceInitial),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &originalObj),
-					testAccCheckObjectBody(&originalObj, "initial versioned object state"),
-					resource.TestCheckResourceAttr(resourceName, "etag", "cee4407fa91906284e2a5e5e03e86b1b"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updateable(rName, true, sourceModified),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &modifiedObj),
-					testAccCheckObjectBody(&modifiedObj, "modified versioned object"),
-					resource.TestCheckResourceAttr(resourceName, "etag", "00b8c73b1b50e7cc932362c7225b8e29"),
-					testAccCheckObjectVersionIDDiffers(&modifiedObj, &originalObj),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "source"},
-				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
-			},
+	testCases := []struct {
+		name            string
+		mode            string
+		retainUntilDate string
+		expectError     bool
+	}{
+		{
+			name: "no retention",
 		},
-	})
-}
+		{
+			name:            "governance",
+			mode:            "GOVERNANCE",
+			retainUntilDate: "2024-02-01T00:00:00Z",
+		},
+		{
+			name:            "compliance expired",
+			mode:            "COMPLIANCE",
+			retainUntilDate: "2023-12-01T00:00:00Z",
+		},
+		{
+			name:            "compliance",
+			mode:            "COMPLIANCE",
+			retainUntilDate: "2024-02-01T00:00:00Z",
+			expectError:     true,
+		},
+	}
 
-func TestAccS3Object_updatesWithVersioningViaAccessPoint(t *testing.T) {
-	ctx := acctest.Context(t)
-	var originalObj, modifiedObj s3.GetObjectOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_s3_object.test"
-	accessPointResourceName := "aws_s3_access_point.test"
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
 
-	sourceInitial := testAccObjectCreateTempFile(t, "initial versioned object state")
-	defer os.Remove(sourceInitial)
-	sourceModified := testAccObjectCreateTempFile(t, "modified versioned object")
-	defer os.Remove(sourceInitial)
+			err := tfs3.CheckObjectComplianceRetention(testCase.mode, testCase.retainUntilDate, now)
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_updateableViaAccessPoint(rName, sourceInitial),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &originalObj),
-					testAccCheckObjectBody(&originalObj, "initial versioned object state"),
-					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "s3", fmt.Sprintf("accesspoint/%s/updateable-key", rName)),
-					resource.TestCheckResourceAttrPair(resourceName, "bucket", accessPointResourceName, "arn"),
-					resource.TestCheckResourceAttr(resourceName, "etag", "cee4407fa91906284e2a5e5e03e86b1b"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updateableViaAccessPoint(rName, sourceModified),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &modifiedObj),
-					testAccCheckObjectBody(&modifiedObj, "modified versioned object"),
-					resource.TestCheckResourceAttr(resourceName, "etag", "00b8c73b1b50e7cc932362c7225b8e29"),
-					testAccCheckObjectVersionIDDiffers(&modifiedObj, &originalObj),
-				),
-			},
-		},
-	})
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", err, want)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_kms(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestValidateObjectRetainUntilDate(t *testing.T) {
+	t.Parallel()
 
-	source := testAccObjectCreateTempFile(t, "{anything will do }")
-	defer os.Remove(source)
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_kmsID(rName, source),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectSSE(ctx, resourceName, "aws:kms"),
-					testAccCheckObjectBody(&obj, "{anything will do }"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "source"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
+	testCases := []struct {
+		name            string
+		retainUntilDate string
+		expectError     bool
+	}{
+		{
+			name: "no retention",
 		},
-	})
+		{
+			name:            "future",
+			retainUntilDate: "2024-02-01T00:00:00Z",
+		},
+		{
+			name:            "future with offset",
+			retainUntilDate: "2024-01-01T13:30:00+01:00",
+		},
+		{
+			name:            "past",
+			retainUntilDate: "2023-12-01T00:00:00Z",
+			expectError:     true,
+		},
+		{
+			name:            "past with offset",
+			retainUntilDate: "2024-01-01T12:30:00+01:00",
+			expectError:     true,
+		},
+		{
+			name:            "now",
+			retainUntilDate: "2024-01-01T12:00:00Z",
+		},
+		{
+			name:            "within clock skew",
+			retainUntilDate: "2024-01-01T11:57:00Z",
+		},
+		{
+			name:            "beyond clock skew",
+			retainUntilDate: "2024-01-01T11:54:59Z",
+			expectError:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.ValidateObjectRetainUntilDate(testCase.retainUntilDate, now)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", err, want)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_sse(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestValidateObjectStorageClass(t *testing.T) {
+	t.Parallel()
 
-	source := testAccObjectCreateTempFile(t, "{anything will do }")
-	defer os.Remove(source)
+	// All storage classes are available in the AWS Standard partition.
+	for _, storageClass := range types.ObjectStorageClass("").Values() {
+		if err := tfs3.ValidateObjectStorageClass(string(storageClass), names.StandardPartitionID); err != nil {
+			t.Errorf("storage class %s: unexpected error: %s", storageClass, err)
+		}
+	}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_sse(rName, source),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectSSE(ctx, resourceName, "AES256"),
-					testAccCheckObjectBody(&obj, "{anything will do }"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "source"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
+	testCases := []struct {
+		name         string
+		storageClass types.ObjectStorageClass
+		partition    string
+		expectError  bool
+	}{
+		{
+			name:         "China",
+			storageClass: types.ObjectStorageClassExpressOnezone,
+			partition:    names.ChinaPartitionID,
+			expectError:  true,
 		},
-	})
-}
+		{
+			name:         "GovCloud",
+			storageClass: types.ObjectStorageClassExpressOnezone,
+			partition:    names.USGovCloudPartitionID,
+			expectError:  true,
+		},
+		{
+			name:         "ISO",
+			storageClass: types.ObjectStorageClassExpressOnezone,
+			partition:    names.ISOPartitionID,
+			expectError:  true,
+		},
+		{
+			name:         "available in GovCloud",
+			storageClass: types.ObjectStorageClassStandardIa,
+			partition:    names.USGovCloudPartitionID,
+		},
+		{
+			name:         "unknown storage class",
+			storageClass: "STANDARD_XA",
+			partition:    names.StandardPartitionID,
+			expectError:  true,
+		},
+	}
 
-func TestAccS3Object_acl(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2, obj3 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_acl(rName, "some_bucket_content", string(types.BucketCannedACLPrivate), true),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "some_bucket_content"),
-					resource.TestCheckResourceAttr(resourceName, "acl", string(types.BucketCannedACLPrivate)),
-					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL"}),
-				),
-			},
-			{
-				Config: testAccObjectConfig_acl(rName, "some_bucket_content", string(types.BucketCannedACLPublicRead), false),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "some_bucket_content"),
-					resource.TestCheckResourceAttr(resourceName, "acl", string(types.BucketCannedACLPublicRead)),
-					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL", "READ"}),
-				),
-			},
-			{
-				Config: testAccObjectConfig_acl(rName, "changed_some_bucket_content", string(types.BucketCannedACLPrivate), true),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj3),
-					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
-					testAccCheckObjectBody(&obj3, "changed_some_bucket_content"),
-					resource.TestCheckResourceAttr(resourceName, "acl", string(types.BucketCannedACLPrivate)),
-					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL"}),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", "content", "force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
-		},
-	})
+			err := tfs3.ValidateObjectStorageClass(string(testCase.storageClass), testCase.partition)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expected error: %t", err, want)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_metadata(t *testing.T) {
-	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
+func TestObjectRetentionFromDefault(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_metadata(rName, "key1", "value1", "key2", "value2"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1"),
-					resource.TestCheckResourceAttr(resourceName, "metadata.key2", "value2"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_metadata(rName, "key1", "value1updated", "key3", "value3"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1updated"),
-					resource.TestCheckResourceAttr(resourceName, "metadata.key3", "value3"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
+	lastModified := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name             string
+		mode             types.ObjectLockMode
+		retainUntilDate  time.Time
+		defaultRetention *types.DefaultRetention
+		expected         bool
+	}{
+		{
+			name:            "no default retention",
+			mode:            types.ObjectLockModeGovernance,
+			retainUntilDate: lastModified.AddDate(0, 0, 1),
 		},
-	})
+		{
+			name:             "no retention",
+			defaultRetention: &types.DefaultRetention{Mode: types.ObjectLockRetentionModeGovernance, Days: aws.Int32(1)},
+		},
+		{
+			name:             "days",
+			mode:             types.ObjectLockModeGovernance,
+			retainUntilDate:  time.Date(2024, time.January, 2, 12, 0, 0, 456_000_000, time.UTC),
+			defaultRetention: &types.DefaultRetention{Mode: types.ObjectLockRetentionModeGovernance, Days: aws.Int32(1)},
+			expected:         true,
+		},
+		{
+			name:             "years",
+			mode:             types.ObjectLockModeCompliance,
+			retainUntilDate:  time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC),
+			defaultRetention: &types.DefaultRetention{Mode: types.ObjectLockRetentionModeCompliance, Years: aws.Int32(2)},
+			expected:         true,
+		},
+		{
+			name:             "other mode",
+			mode:             types.ObjectLockModeCompliance,
+			retainUntilDate:  lastModified.AddDate(0, 0, 1),
+			defaultRetention: &types.DefaultRetention{Mode: types.ObjectLockRetentionModeGovernance, Days: aws.Int32(1)},
+		},
+		{
+			name:             "explicit date",
+			mode:             types.ObjectLockModeGovernance,
+			retainUntilDate:  lastModified.AddDate(0, 0, 10),
+			defaultRetention: &types.DefaultRetention{Mode: types.ObjectLockRetentionModeGovernance, Days: aws.Int32(1)},
+		},
+		{
+			name:             "explicit date within a minute",
+			mode:             types.ObjectLockModeGovernance,
+			retainUntilDate:  lastModified.AddDate(0, 0, 1).Add(time.Minute),
+			defaultRetention: &types.DefaultRetention{Mode: types.ObjectLockRetentionModeGovernance, Days: aws.Int32(1)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var retainUntilDate *time.Time
+			if !testCase.retainUntilDate.IsZero() {
+				retainUntilDate = aws.Time(testCase.retainUntilDate)
+			}
+
+			got := tfs3.ObjectRetentionFromDefault(testCase.mode, retainUntilDate, aws.Time(lastModified), testCase.defaultRetention)
+
+			if got != testCase.expected {
+				t.Errorf("inherited = %t, want %t", got, testCase.expected)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_storageClass(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestSuppressEquivalentObjectDate(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_content(rName, "some_bucket_content"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
-					testAccCheckObjectStorageClass(ctx, resourceName, "STANDARD"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_storageClass(rName, "REDUCED_REDUNDANCY"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "storage_class", "REDUCED_REDUNDANCY"),
-					testAccCheckObjectStorageClass(ctx, resourceName, "REDUCED_REDUNDANCY"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_storageClass(rName, "GLACIER"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					// Can't GetObject on an object in Glacier without restoring it.
-					resource.TestCheckResourceAttr(resourceName, "storage_class", "GLACIER"),
-					testAccCheckObjectStorageClass(ctx, resourceName, "GLACIER"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_storageClass(rName, "INTELLIGENT_TIERING"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "storage_class", "INTELLIGENT_TIERING"),
-					testAccCheckObjectStorageClass(ctx, resourceName, "INTELLIGENT_TIERING"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_storageClass(rName, "DEEP_ARCHIVE"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					// 	Can't GetObject on an object in DEEP_ARCHIVE without restoring it.
-					resource.TestCheckResourceAttr(resourceName, "storage_class", "DEEP_ARCHIVE"),
-					testAccCheckObjectStorageClass(ctx, resourceName, "DEEP_ARCHIVE"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"content", "force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "same",
+			old:      "2024-01-01T00:00:00Z",
+			new:      "2024-01-01T00:00:00Z",
+			expected: true,
 		},
-	})
+		{
+			name:     "fractional seconds",
+			old:      "2024-01-01T00:00:00Z",
+			new:      "2024-01-01T00:00:00.000Z",
+			expected: true,
+		},
+		{
+			name:     "offset",
+			old:      "2024-01-01T00:00:00Z",
+			new:      "2024-01-01T01:00:00+01:00",
+			expected: true,
+		},
+		{
+			name: "different seconds",
+			old:  "2024-01-01T00:00:00Z",
+			new:  "2024-01-01T00:00:01Z",
+		},
+		{
+			name: "different fractional seconds",
+			old:  "2024-01-01T00:00:00Z",
+			new:  "2024-01-01T00:00:00.5Z",
+		},
+		{
+			name: "unset",
+			old:  "2024-01-01T00:00:00Z",
+		},
+		{
+			name: "invalid",
+			old:  "2024-01-01T00:00:00Z",
+			new:  "2024-01-01",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.SuppressEquivalentObjectDate("object_lock_retain_until_date", testCase.old, testCase.new, nil), testCase.expected; got != want {
+				t.Errorf("SuppressEquivalentObjectDate(%q, %q) = %t, want %t", testCase.old, testCase.new, got, want)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_tags(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	key := "test-key"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestSuppressEquivalentObjectExpires(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updatedTags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_noTags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj3),
-					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
-					testAccCheckObjectBody(&obj3, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_tags(rName, key, "changed stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj4),
-					testAccCheckObjectVersionIDDiffers(&obj4, &obj3),
-					testAccCheckObjectBody(&obj4, "changed stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"content", "force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
-			},
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "same",
+			old:      "Thu, 01 Jan 2099 00:00:00 GMT",
+			new:      "Thu, 01 Jan 2099 00:00:00 GMT",
+			expected: true,
 		},
-	})
+		{
+			name:     "RFC3339",
+			old:      "Thu, 01 Jan 2099 00:00:00 GMT",
+			new:      "2099-01-01T00:00:00Z",
+			expected: true,
+		},
+		{
+			name:     "RFC3339 with offset",
+			old:      "Thu, 01 Jan 2099 00:00:00 GMT",
+			new:      "2099-01-01T01:00:00+01:00",
+			expected: true,
+		},
+		{
+			name: "different",
+			old:  "Thu, 01 Jan 2099 00:00:00 GMT",
+			new:  "2099-01-02T00:00:00Z",
+		},
+		{
+			name: "invalid old",
+			old:  "0",
+			new:  "2099-01-01T00:00:00Z",
+		},
+		{
+			name: "removed",
+			old:  "Thu, 01 Jan 2099 00:00:00 GMT",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.SuppressEquivalentObjectExpires("expires", testCase.old, testCase.new, nil), testCase.expected; got != want {
+				t.Errorf("SuppressEquivalentObjectExpires(%q, %q) = %t, want %t", testCase.old, testCase.new, got, want)
+			}
+		})
+	}
 }
 
-func TestAccS3Object_tagsLeadingSingleSlash(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_s3_object.object"
-	key := "/test-key"
+func TestIsKMSKeyARN(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
+	testCases := []struct {
+		value    string
+		expected bool
+	}{
+		{"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", true}, //lintignore:AWSAT003,AWSAT005
+		{"arn:aws:kms:us-west-2:123456789012:alias/example", false},                           //lintignore:AWSAT003,AWSAT005
+		{"1234abcd-12ab-34cd-56ef-1234567890ab", false},
+		{"alias/example", false},
+		{"arn:aws:s3:::example/key/1234abcd-12ab-34cd-56ef-1234567890ab", false}, //lintignore:AWSAT005
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.value, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfs3.IsKMSKeyARN(testCase.value); got != testCase.expected {
+				t.Errorf("IsKMSKeyARN(%q) = %t, want %t", testCase.value, got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestValidateObjectAccelerateBucket(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		bucket      string
+		expectError bool
+	}{
+		{"example-bucket", false},
+		{"example-bucket-1", false},
+		{"example.bucket", true},
+		{"Example-Bucket", true},
+		{"-example", true},
+		{"ex", true},
+		{"arn:aws:s3:us-west-2:123456789012:accesspoint/example", true}, //lintignore:AWSAT003,AWSAT005
+		{"example--usw2-az1--x-s3", true},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.bucket, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.ValidateObjectAccelerateBucket(testCase.bucket)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateObjectAccelerateBucket(%q) error = %v, expected error: %t", testCase.bucket, err, want)
+			}
+		})
+	}
+}
+
+func TestObjectClientOptFns(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected s3.Options
+	}{
+		{
+			name: "defaults",
+			raw:  map[string]interface{}{},
+		},
+		{
+			name: "region",
+			raw: map[string]interface{}{
+				"region": "eu-west-1", //lintignore:AWSAT003
 			},
-			{
-				Config: testAccObjectConfig_updatedTags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-				),
+			expected: s3.Options{
+				Region: "eu-west-1", //lintignore:AWSAT003
 			},
-			{
-				Config: testAccObjectConfig_noTags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj3),
-					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
-					testAccCheckObjectBody(&obj3, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-				),
+		},
+		{
+			name: "endpoint and use_path_style",
+			raw: map[string]interface{}{
+				"endpoint":       "https://bucket.vpce-1a2b3c4d-5e6f.s3.us-west-2.vpce.amazonaws.com", //lintignore:AWSAT003
+				"use_path_style": true,
 			},
-			{
-				Config: testAccObjectConfig_tags(rName, key, "changed stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj4),
-					testAccCheckObjectVersionIDDiffers(&obj4, &obj3),
-					testAccCheckObjectBody(&obj4, "changed stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
+			expected: s3.Options{
+				BaseEndpoint: aws.String("https://bucket.vpce-1a2b3c4d-5e6f.s3.us-west-2.vpce.amazonaws.com"), //lintignore:AWSAT003
+				UsePathStyle: true,
 			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"content", "force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
+		},
+		{
+			name: "use_accelerate_endpoint",
+			raw: map[string]interface{}{
+				"use_accelerate_endpoint": true,
+			},
+			expected: s3.Options{
+				UseAccelerate: true,
 			},
 		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := map[string]interface{}{
+				"bucket": "test-bucket",
+				"key":    "test-key",
+			}
+			for k, v := range testCase.raw {
+				raw[k] = v
+			}
+			d := schema.TestResourceDataRaw(t, tfs3.ResourceObject().Schema, raw)
+
+			var got s3.Options
+			for _, fn := range tfs3.ObjectClientOptFns(d) {
+				fn(&got)
+			}
+
+			if got, want := got.Region, testCase.expected.Region; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+			if got, want := aws.ToString(got.BaseEndpoint), aws.ToString(testCase.expected.BaseEndpoint); got != want {
+				t.Errorf("BaseEndpoint = %q, want %q", got, want)
+			}
+			if got, want := got.UsePathStyle, testCase.expected.UsePathStyle; got != want {
+				t.Errorf("UsePathStyle = %t, want %t", got, want)
+			}
+			if got, want := got.UseAccelerate, testCase.expected.UseAccelerate; got != want {
+				t.Errorf("UseAccelerate = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestObjectEndpointValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		endpoint    string
+		expectError bool
+	}{
+		"https":              {endpoint: "https://bucket.vpce-1a2b3c4d-5e6f.s3.us-west-2.vpce.amazonaws.com"}, //lintignore:AWSAT003
+		"http with port":     {endpoint: "http://localhost:9000"},
+		"no scheme":          {endpoint: "s3.example.com", expectError: true},
+		"unsupported scheme": {endpoint: "ftp://s3.example.com", expectError: true},
+		"empty host":         {endpoint: "https://", expectError: true},
+	}
+
+	validateFunc := tfs3.ResourceObject().Schema["endpoint"].ValidateFunc
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validateFunc(testCase.endpoint, "endpoint")
+
+			if got, want := len(errs) > 0, testCase.expectError; got != want {
+				t.Errorf("validate(%q) errors = %v, expected error: %t", testCase.endpoint, errs, want)
+			}
+		})
+	}
+}
+
+func TestObjectACLValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		acl         string
+		expectError bool
+	}{
+		"private":                   {acl: string(types.ObjectCannedACLPrivate)},
+		"bucket-owner-read":         {acl: string(types.ObjectCannedACLBucketOwnerRead)},
+		"bucket-owner-full-control": {acl: string(types.ObjectCannedACLBucketOwnerFullControl)},
+		"bucket ACL":                {acl: "log-delivery-write", expectError: true},
+		"invalid":                   {acl: "bucket-owner", expectError: true},
+	}
+
+	validateDiagFunc := tfs3.ResourceObject().Schema["acl"].ValidateDiagFunc
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateDiagFunc(testCase.acl, cty.GetAttrPath("acl"))
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("validate(%q) diagnostics = %v, expected error: %t", testCase.acl, diags, want)
+			}
+		})
+	}
+}
+
+func TestExpandObjectGrantees(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, tfs3.ResourceObject().Schema, map[string]interface{}{
+		"bucket":     "test-bucket",
+		"key":        "test-key",
+		"grant_read": []interface{}{"uri=http://acs.amazonaws.com/groups/global/AuthenticatedUsers", "id=111122223333"},
 	})
+
+	if got, want := aws.ToString(tfs3.ExpandObjectGrantees(d, "grant_read")), `id="111122223333", uri="http://acs.amazonaws.com/groups/global/AuthenticatedUsers"`; got != want {
+		t.Errorf("expandObjectGrantees(grant_read) = %q, want %q", got, want)
+	}
+
+	if got := tfs3.ExpandObjectGrantees(d, "grant_read_acp"); got != nil {
+		t.Errorf("expandObjectGrantees(grant_read_acp) = %q, want nil", aws.ToString(got))
+	}
+
+	if !tfs3.HasObjectGrants(d) {
+		t.Error("hasObjectGrants = false, want true")
+	}
 }
 
-func TestAccS3Object_tagsLeadingMultipleSlashes(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_s3_object.object"
-	key := "/////test-key"
+func TestFlattenObjectGrantees(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updatedTags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-				),
+	owner := &types.Owner{ID: aws.String("owner")}
+	grants := []types.Grant{
+		{
+			Grantee:    &types.Grantee{ID: aws.String("owner"), Type: types.TypeCanonicalUser},
+			Permission: types.PermissionFullControl,
+		},
+		{
+			Grantee:    &types.Grantee{ID: aws.String("111122223333"), Type: types.TypeCanonicalUser},
+			Permission: types.PermissionFullControl,
+		},
+		{
+			Grantee:    &types.Grantee{ID: aws.String("111122223333"), Type: types.TypeCanonicalUser},
+			Permission: types.PermissionRead,
+		},
+		{
+			Grantee:    &types.Grantee{Type: types.TypeGroup, URI: aws.String("http://acs.amazonaws.com/groups/s3/LogDelivery")},
+			Permission: types.PermissionRead,
+		},
+	}
+
+	testCases := map[string]struct {
+		permission types.Permission
+		configured []string
+		expected   []string
+	}{
+		"owner not configured": {
+			permission: types.PermissionFullControl,
+			configured: []string{"id=111122223333"},
+			expected:   []string{"id=111122223333"},
+		},
+		"owner configured": {
+			permission: types.PermissionFullControl,
+			configured: []string{"id=owner"},
+			expected:   []string{"id=owner", "id=111122223333"},
+		},
+		"user and group": {
+			permission: types.PermissionRead,
+			expected:   []string{"id=111122223333", "uri=http://acs.amazonaws.com/groups/s3/LogDelivery"},
+		},
+		"none": {
+			permission: types.PermissionWriteAcp,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfs3.FlattenObjectGrantees(grants, owner, testCase.permission, testCase.configured)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateObjectACLOwnership(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		acl         string
+		ownership   types.ObjectOwnership
+		expectError bool
+	}{
+		"BucketOwnerEnforced": {
+			acl:         string(types.ObjectCannedACLBucketOwnerRead),
+			ownership:   types.ObjectOwnershipBucketOwnerEnforced,
+			expectError: true,
+		},
+		"BucketOwnerEnforced private": {
+			acl:         string(types.ObjectCannedACLPrivate),
+			ownership:   types.ObjectOwnershipBucketOwnerEnforced,
+			expectError: true,
+		},
+		"BucketOwnerEnforced bucket-owner-full-control": {
+			acl:       string(types.ObjectCannedACLBucketOwnerFullControl),
+			ownership: types.ObjectOwnershipBucketOwnerEnforced,
+		},
+		"BucketOwnerEnforced no acl": {
+			ownership: types.ObjectOwnershipBucketOwnerEnforced,
+		},
+		"BucketOwnerPreferred": {
+			acl:       string(types.ObjectCannedACLBucketOwnerRead),
+			ownership: types.ObjectOwnershipBucketOwnerPreferred,
+		},
+		"ObjectWriter": {
+			acl:       string(types.ObjectCannedACLBucketOwnerRead),
+			ownership: types.ObjectOwnershipObjectWriter,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.ValidateObjectACLOwnership(testCase.acl, testCase.ownership)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if !strings.Contains(err.Error(), "BucketOwnerEnforced, which disables ACLs") {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestObjectContentSecretSensitive(t *testing.T) {
+	t.Parallel()
+
+	schema := tfs3.ResourceObject().Schema
+
+	if !schema["content_secret"].Sensitive {
+		t.Error("content_secret is not sensitive")
+	}
+
+	// content_secret is an alternative to content, which stays visible in plan output.
+	if schema["content"].Sensitive {
+		t.Error("content is sensitive")
+	}
+}
+
+func TestObjectAccessDeniedError(t *testing.T) {
+	t.Parallel()
+
+	errAccessDenied := func(message string) error {
+		return &smithy.GenericAPIError{Code: "AccessDenied", Message: message}
+	}
+
+	testCases := map[string]struct {
+		err      error
+		action   string
+		config   map[string]interface{}
+		expected []string
+		absent   []string
+	}{
+		"not access denied": {
+			err:    &smithy.GenericAPIError{Code: "NoSuchBucket", Message: "The specified bucket does not exist"},
+			action: "s3:PutObject",
+			config: map[string]interface{}{},
+			absent: []string{"access denied, likely causes"},
+		},
+		"kms message": {
+			err:      errAccessDenied("User: arn:aws:iam::123456789012:user/test is not authorized to perform: kms:GenerateDataKey on resource: arn:aws:kms:us-west-2:123456789012:key/test"),
+			action:   "s3:PutObject",
+			config:   map[string]interface{}{},
+			expected: []string{"the KMS key policy or the caller's IAM policy doesn't allow the KMS action"},
+		},
+		"kms_key_id": {
+			err:    errAccessDenied("Access Denied"),
+			action: "s3:PutObject",
+			config: map[string]interface{}{
+				"kms_key_id": "arn:aws:kms:us-west-2:123456789012:key/test",
 			},
-			{
-				Config: testAccObjectConfig_noTags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj3),
-					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
-					testAccCheckObjectBody(&obj3, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-				),
+			expected: []string{
+				"the policy of KMS key (arn:aws:kms:us-west-2:123456789012:key/test) doesn't allow kms:GenerateDataKey and kms:Decrypt",
+				"the caller's IAM policy doesn't allow s3:PutObject or the bucket policy denies it",
 			},
-			{
-				Config: testAccObjectConfig_tags(rName, key, "changed stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj4),
-					testAccCheckObjectVersionIDDiffers(&obj4, &obj3),
-					testAccCheckObjectBody(&obj4, "changed stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
+		},
+		"server_side_encryption aws:kms": {
+			err:    errAccessDenied("Access Denied"),
+			action: "s3:PutObject",
+			config: map[string]interface{}{
+				"server_side_encryption": "aws:kms",
 			},
+			expected: []string{"the policy of the bucket's default KMS key doesn't allow kms:GenerateDataKey and kms:Decrypt"},
 		},
-	})
+		"bucket policy": {
+			err:      errAccessDenied("User: arn:aws:iam::123456789012:user/test is not authorized to perform: s3:PutObject on resource: \"arn:aws:s3:::test/test\" with an explicit deny in a resource-based policy"),
+			action:   "s3:PutObject",
+			config:   map[string]interface{}{},
+			expected: []string{"the bucket policy denies s3:PutObject"},
+			absent:   []string{"KMS", "IAM policy"},
+		},
+		"identity policy": {
+			err:      errAccessDenied("User: arn:aws:iam::123456789012:user/test is not authorized to perform: s3:PutObjectAcl on resource: \"arn:aws:s3:::test/test\" because no identity-based policy allows the s3:PutObjectAcl action"),
+			action:   "s3:PutObjectAcl",
+			config:   map[string]interface{}{},
+			expected: []string{"the caller's IAM policy doesn't allow s3:PutObjectAcl"},
+			absent:   []string{"bucket policy"},
+		},
+		"additional permissions": {
+			err:    errAccessDenied("Access Denied"),
+			action: "s3:PutObject",
+			config: map[string]interface{}{
+				"acl": "private",
+				"tags_all": map[string]interface{}{
+					"Key1": "Value1",
+				},
+				"object_lock_legal_hold_status": "ON",
+			},
+			expected: []string{
+				"acl also requires s3:PutObjectAcl",
+				"tags also require s3:PutObjectTagging",
+				"object_lock_legal_hold_status also requires s3:PutObjectLegalHold",
+			},
+			absent: []string{"KMS", "object_lock_mode"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tfs3.ResourceObject().Schema, testCase.config)
+			err := tfs3.ObjectAccessDeniedError(testCase.err, testCase.action, d)
+
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("expected error to wrap %q, got %q", testCase.err, err)
+			}
+			for _, v := range testCase.expected {
+				if !strings.Contains(err.Error(), v) {
+					t.Errorf("expected error to contain %q, got %q", v, err)
+				}
+			}
+			for _, v := range testCase.absent {
+				if strings.Contains(err.Error(), v) {
+					t.Errorf("expected error not to contain %q, got %q", v, err)
+				}
+			}
+		})
+	}
+}
+
+func TestObjectIfNoneMatchError(t *testing.T) {
+	t.Parallel()
+
+	preconditionFailed := &smithyhttp.ResponseError{
+		Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusPreconditionFailed}},
+		Err:      &smithy.GenericAPIError{Code: "PreconditionFailed"},
+	}
+
+	testCases := []struct {
+		name     string
+		err      error
+		etag     string
+		expected string
+	}{
+		{
+			name:     "not conditional",
+			err:      preconditionFailed,
+			expected: preconditionFailed.Error(),
+		},
+		{
+			name:     "exists",
+			err:      preconditionFailed,
+			etag:     "*",
+			expected: "the object already exists and if_none_match_etag is *, so it wasn't written: " + preconditionFailed.Error(),
+		},
+		{
+			name:     "ETag matches",
+			err:      preconditionFailed,
+			etag:     "d41d8cd98f00b204e9800998ecf8427e",
+			expected: "the object's current ETag matches if_none_match_etag (d41d8cd98f00b204e9800998ecf8427e), so it wasn't written: " + preconditionFailed.Error(),
+		},
+		{
+			name:     "other error",
+			err:      &smithy.GenericAPIError{Code: "AccessDenied", Message: "Access Denied"},
+			etag:     "*",
+			expected: "api error AccessDenied: Access Denied",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.ObjectIfNoneMatchError(testCase.err, testCase.etag)
+
+			if got, want := err.Error(), testCase.expected; got != want {
+				t.Errorf("ObjectIfNoneMatchError() = %q, want %q", got, want)
+			}
+
+			if !errors.Is(err, testCase.err) {
+				t.Errorf("ObjectIfNoneMatchError() doesn't wrap %v", testCase.err)
+			}
+		})
+	}
+}
+
+func TestFlattenObjectExpiration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected []interface{}
+	}{
+		{
+			name:     "no lifecycle rule",
+			expected: []interface{}{},
+		},
+		{
+			name:  "lifecycle rule",
+			value: `expiry-date="Fri, 23 Dec 2012 00:00:00 GMT", rule-id="picture-deletion-rule"`,
+			expected: []interface{}{map[string]interface{}{
+				"expiry_date": "2012-12-23T00:00:00Z",
+				"rule_id":     "picture-deletion-rule",
+			}},
+		},
+		{
+			name:  "URL encoded rule ID",
+			value: `expiry-date="Sat, 01 Jan 2000 00:00:00 GMT", rule-id="delete%20after%2030%2Fdays"`,
+			expected: []interface{}{map[string]interface{}{
+				"expiry_date": "2000-01-01T00:00:00Z",
+				"rule_id":     "delete after 30/days",
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfs3.FlattenObjectExpiration(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestComputeObjectChecksums(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		algorithm      types.ChecksumAlgorithm
+		partSize       int64
+		output         *manager.UploadOutput
+		expectedError  bool
+		expectedVerify bool
+	}{
+		{
+			name:      "single part",
+			algorithm: types.ChecksumAlgorithmSha256,
+			partSize:  manager.DefaultUploadPartSize,
+			output: &manager.UploadOutput{
+				ChecksumSHA256: aws.String("1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
+			},
+			expectedVerify: true,
+		},
+		{
+			name:      "single part mismatch",
+			algorithm: types.ChecksumAlgorithmSha256,
+			partSize:  manager.DefaultUploadPartSize,
+			output: &manager.UploadOutput{
+				ChecksumSHA256: aws.String("q/d4Ig=="),
+			},
+		},
+		{
+			name:      "multipart",
+			algorithm: types.ChecksumAlgorithmCrc32,
+			partSize:  10,
+			output: &manager.UploadOutput{
+				CompletedParts: []types.CompletedPart{
+					{ChecksumCRC32: aws.String("Mh5tBQ=="), PartNumber: aws.Int32(1)},
+					{ChecksumCRC32: aws.String("PN7DOw=="), PartNumber: aws.Int32(2)},
+					{ChecksumCRC32: aws.String("jmAUaA=="), PartNumber: aws.Int32(3)},
+				},
+				UploadID: "test-upload-id",
+			},
+			expectedVerify: true,
+		},
+		{
+			name:      "multipart part mismatch",
+			algorithm: types.ChecksumAlgorithmCrc32,
+			partSize:  10,
+			output: &manager.UploadOutput{
+				CompletedParts: []types.CompletedPart{
+					{ChecksumCRC32: aws.String("Mh5tBQ=="), PartNumber: aws.Int32(1)},
+					{ChecksumCRC32: aws.String("Mh5tBQ=="), PartNumber: aws.Int32(2)},
+					{ChecksumCRC32: aws.String("jmAUaA=="), PartNumber: aws.Int32(3)},
+				},
+				UploadID: "test-upload-id",
+			},
+		},
+		{
+			name:      "multipart part count mismatch",
+			algorithm: types.ChecksumAlgorithmCrc32,
+			partSize:  10,
+			output: &manager.UploadOutput{
+				CompletedParts: []types.CompletedPart{
+					{ChecksumCRC32: aws.String("Mh5tBQ=="), PartNumber: aws.Int32(1)},
+					{ChecksumCRC32: aws.String("PN7DOw=="), PartNumber: aws.Int32(2)},
+				},
+				UploadID: "test-upload-id",
+			},
+		},
+		{
+			name:          "unsupported algorithm",
+			partSize:      manager.DefaultUploadPartSize,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := strings.NewReader("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+
+			checksums, err := tfs3.ComputeObjectChecksums(body, testCase.algorithm, testCase.partSize, manager.MaxUploadParts)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("ComputeObjectChecksums() err %t, want %t", got, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if offset, _ := body.Seek(0, io.SeekCurrent); offset != 0 {
+				t.Errorf("body not rewound, offset = %d", offset)
+			}
+
+			err = tfs3.VerifyObjectChecksums(checksums, testCase.output)
+
+			if got, want := err == nil, testCase.expectedVerify; got != want {
+				t.Errorf("VerifyObjectChecksums() = %v, want success %t", err, want)
+			}
+		})
+	}
+}
+
+func TestComputeObjectSHA256(t *testing.T) {
+	t.Parallel()
+
+	body := strings.NewReader("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+
+	// A partially read body is hashed from the start.
+	if _, err := body.Seek(5, io.SeekStart); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	hash, err := tfs3.ComputeObjectSHA256(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := hex.EncodeToString(hash), "d6ec6898de87ddac6e5b3611708a7aa1c2d298293349cc1a6c299a1db7149d38"; got != want {
+		t.Errorf("SHA-256 digest = %q, want %q", got, want)
+	}
+
+	if offset, _ := body.Seek(0, io.SeekCurrent); offset != 0 {
+		t.Errorf("body not rewound, offset = %d", offset)
+	}
+}
+
+func TestVerifyObjectETag(t *testing.T) {
+	t.Parallel()
+
+	contentMD5, err := tfs3.ComputeObjectMD5(strings.NewReader("ABCDEFGHIJKLMNOPQRSTUVWXYZ"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := hex.EncodeToString(contentMD5), "437bba8e0bf58337674f4539e75186ac"; got != want {
+		t.Errorf("MD5 digest = %q, want %q", got, want)
+	}
+
+	testCases := []struct {
+		name          string
+		output        *manager.UploadOutput
+		expectedError bool
+	}{
+		{
+			name:   "match",
+			output: &manager.UploadOutput{ETag: aws.String(`"437bba8e0bf58337674f4539e75186ac"`)},
+		},
+		{
+			name:          "mismatch",
+			output:        &manager.UploadOutput{ETag: aws.String(`"d41d8cd98f00b204e9800998ecf8427e"`)},
+			expectedError: true,
+		},
+		{
+			name:   "multipart",
+			output: &manager.UploadOutput{ETag: aws.String(`"9b2cf535f27731c974343645a3985328-2"`), UploadID: "test-upload-id"},
+		},
+		{
+			name:   "KMS",
+			output: &manager.UploadOutput{ETag: aws.String(`"d41d8cd98f00b204e9800998ecf8427e"`), ServerSideEncryption: types.ServerSideEncryptionAwsKms},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.VerifyObjectETag(testCase.output, contentMD5)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Errorf("VerifyObjectETag() err %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestNewObjectUploadRetryer(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		tfMap               map[string]interface{}
+		retryerV1           bool
+		expectedMaxAttempts int
+		expectedMaxDelay    time.Duration
+	}{
+		{
+			name:                "default",
+			expectedMaxAttempts: retry.DefaultMaxAttempts,
+			expectedMaxDelay:    retry.DefaultMaxBackoff,
+		},
+		{
+			name: "max attempts and base delay",
+			tfMap: map[string]interface{}{
+				"base_delay":   "1ms",
+				"max_attempts": 6,
+			},
+			expectedMaxAttempts: 6,
+			expectedMaxDelay:    4 * time.Millisecond,
+		},
+		{
+			name: "max attempts and base delay without RetryerV2",
+			tfMap: map[string]interface{}{
+				"base_delay":   "1ms",
+				"max_attempts": 6,
+			},
+			retryerV1:           true,
+			expectedMaxAttempts: 6,
+			expectedMaxDelay:    4 * time.Millisecond,
+		},
+		{
+			name: "invalid base delay",
+			tfMap: map[string]interface{}{
+				"base_delay":   "soon",
+				"max_attempts": 6,
+			},
+			expectedMaxAttempts: 6,
+			expectedMaxDelay:    retry.DefaultMaxBackoff,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Allow a single retry before the client-side retry quota is exhausted.
+			var r aws.Retryer = retry.NewStandard(func(o *retry.StandardOptions) {
+				o.RateLimiter = ratelimit.NewTokenRateLimit(o.RetryCost)
+			})
+			if testCase.retryerV1 {
+				r = struct{ aws.Retryer }{r}
+			}
+
+			r = tfs3.NewObjectUploadRetryer(r, testCase.tfMap)
+
+			if got, want := r.MaxAttempts(), testCase.expectedMaxAttempts; got != want {
+				t.Errorf("MaxAttempts() = %d, want %d", got, want)
+			}
+
+			for _, code := range []string{"RequestTimeout", "SlowDown"} {
+				if !r.IsErrorRetryable(&smithy.GenericAPIError{Code: code}) {
+					t.Errorf("IsErrorRetryable(%s) = false, want true", code)
+				}
+			}
+
+			if _, ok := r.(aws.RetryerV2); !ok {
+				t.Errorf("Retryer doesn't implement RetryerV2")
+			}
+
+			delay, err := r.RetryDelay(3, &smithy.GenericAPIError{Code: "SlowDown"})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if delay > testCase.expectedMaxDelay {
+				t.Errorf("RetryDelay() = %s, want <= %s", delay, testCase.expectedMaxDelay)
+			}
+
+			retryErr := &smithy.GenericAPIError{Code: "SlowDown"}
+			if _, err := r.GetRetryToken(context.Background(), retryErr); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if _, err := r.GetRetryToken(context.Background(), retryErr); err == nil {
+				t.Errorf("GetRetryToken() succeeded with an exhausted retry quota")
+			}
+		})
+	}
+}
+
+func TestObjectCopySource(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		bucket    string
+		key       string
+		versionID string
+		expected  string
+	}{
+		{
+			name:     "key",
+			bucket:   "test-bucket",
+			key:      "test/key",
+			expected: "test-bucket/test/key",
+		},
+		{
+			name:      "version",
+			bucket:    "test-bucket",
+			key:       "test-key",
+			versionID: "3/L4kqtJlcpXroDTDmJ+rmSpXd3dIbrHY+MTRCxf3vjVBH40Nr8X8gdRQBpUMLUo",
+			expected:  "test-bucket/test-key?versionId=3%2FL4kqtJlcpXroDTDmJ%2BrmSpXd3dIbrHY%2BMTRCxf3vjVBH40Nr8X8gdRQBpUMLUo",
+		},
+		// A literal "+" is decoded as a space, so spaces and "+" are both percent-encoded.
+		{
+			name:     "plus and space",
+			bucket:   "test-bucket",
+			key:      "a+b c",
+			expected: "test-bucket/a%2Bb%20c",
+		},
+		{
+			name:     "percent",
+			bucket:   "test-bucket",
+			key:      "100%/a%20b",
+			expected: "test-bucket/100%25/a%2520b",
+		},
+		{
+			name:     "reserved characters",
+			bucket:   "test-bucket",
+			key:      "a?b#c&d=e",
+			expected: "test-bucket/a%3Fb%23c%26d%3De",
+		},
+		{
+			name:     "unicode",
+			bucket:   "test-bucket",
+			key:      "été/😀",
+			expected: "test-bucket/%C3%A9t%C3%A9/%F0%9F%98%80",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.ObjectCopySource(testCase.bucket, testCase.key, testCase.versionID), testCase.expected; got != want {
+				t.Errorf("ObjectCopySource() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestObjectStreamPartSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		contentLength int64
+		partSize      int64
+		expected      int64
+	}{
+		{
+			name:          "small",
+			contentLength: 1024,
+			partSize:      manager.DefaultUploadPartSize,
+			expected:      manager.DefaultUploadPartSize,
+		},
+		{
+			name:          "configured part size",
+			contentLength: 1024,
+			partSize:      64 * 1024 * 1024,
+			expected:      64 * 1024 * 1024,
+		},
+		{
+			name:          "exceeds maximum parts",
+			contentLength: 100 * 1024 * 1024 * 1024, // 100 GiB
+			partSize:      manager.DefaultUploadPartSize,
+			expected:      10737419, // ceil(100 GiB / 10,000)
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.ObjectStreamPartSize(testCase.contentLength, testCase.partSize, manager.MaxUploadParts), testCase.expected; got != want {
+				t.Errorf("ObjectStreamPartSize(%d) = %d, want %d", testCase.contentLength, got, want)
+			}
+		})
+	}
+}
+
+func TestObjectAppendOffset(t *testing.T) {
+	t.Parallel()
+
+	const current = "line 1\n"
+	digest := sha256.Sum256([]byte(current))
+	currentSHA256 := hex.EncodeToString(digest[:])
+
+	testCases := map[string]struct {
+		body           string
+		size           int64
+		contentSHA256  string
+		expectedOffset int64
+		expectAppend   bool
+	}{
+		"appended": {
+			body:           current + "line 2\n",
+			size:           int64(len(current)),
+			contentSHA256:  currentSHA256,
+			expectedOffset: int64(len(current)),
+			expectAppend:   true,
+		},
+		"unchanged": {
+			body:          current,
+			size:          int64(len(current)),
+			contentSHA256: currentSHA256,
+		},
+		"truncated": {
+			body:          "line",
+			size:          int64(len(current)),
+			contentSHA256: currentSHA256,
+		},
+		"rewritten": {
+			body:          "line 0\nline 2\n",
+			size:          int64(len(current)),
+			contentSHA256: currentSHA256,
+		},
+		"empty object": {
+			body: "line 1\n",
+		},
+		"digest unknown": {
+			body: current + "line 2\n",
+			size: int64(len(current)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := strings.NewReader(testCase.body)
+			offset, ok, err := tfs3.ObjectAppendOffset(body, testCase.size, testCase.contentSHA256)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := ok, testCase.expectAppend; got != want {
+				t.Fatalf("append = %t, want %t", got, want)
+			}
+
+			if got, want := offset, testCase.expectedOffset; got != want {
+				t.Errorf("offset = %d, want %d", got, want)
+			}
+
+			// The body is positioned at the offset, or at its start to be uploaded in full.
+			rest, err := io.ReadAll(body)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := string(rest), testCase.body[offset:]; got != want {
+				t.Errorf("remaining body = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccS3Object_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, ""),
+					resource.TestCheckNoResourceAttr(resourceName, "acl"),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", fmt.Sprintf("%s/test-key", rName)),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "content"),
+					resource.TestCheckNoResourceAttr(resourceName, "content_base64"),
+					resource.TestCheckResourceAttr(resourceName, "content_disposition", ""),
+					resource.TestCheckResourceAttr(resourceName, "content_encoding", ""),
+					resource.TestCheckResourceAttr(resourceName, "content_language", ""),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
+					resource.TestCheckResourceAttr(resourceName, "delete_marker", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "expiration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key", "test-key"),
+					resource.TestCheckNoResourceAttr(resourceName, "kms_key_id"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "last_modified"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_url", fmt.Sprintf("https://%s/test-key", testAccBucketRegionalDomainName(rName, acctest.Region()))),
+					resource.TestCheckResourceAttr(resourceName, "parts_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "replication_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", "AES256"),
+					resource.TestCheckNoResourceAttr(resourceName, "source"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_hash"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "website_redirect", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_directoryPlaceholder(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_directoryPlaceholder(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, ""),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", fmt.Sprintf("%s/folder/", rName)),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "d41d8cd98f00b204e9800998ecf8427e"),
+					resource.TestCheckResourceAttr(resourceName, "id", "folder/"),
+					resource.TestCheckResourceAttr(resourceName, "key", "folder/"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/folder/", rName),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("%s/folder/", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceObject(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_Disappears_bucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucket(), "aws_s3_bucket.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_upgradeFromV4(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.S3ServiceID),
+		CheckDestroy: testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "4.67.0",
+					},
+				},
+				Config: testAccObjectConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccObjectConfig_basic(rName),
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_timeouts(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_timeouts(rName, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "initial"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_timeouts(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectBody(&obj2, "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_region(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_region(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "11"),
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config:      testAccObjectConfig_region(rName, acctest.Region()),
+				ExpectError: regexache.MustCompile(fmt.Sprintf(`is in region %s, not %s`, acctest.AlternateRegion(), acctest.Region())),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_regionDefaultAndIgnoreTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("providerkey1", "providervalue1", "ignorekey"),
+					testAccObjectConfig_regionTags1(rName, acctest.AlternateRegion(), "Key1", "Value1"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					testAccCheckObjectUpdateTags(ctx, resourceName, nil, map[string]string{"ignorekey1": "ignorevalue1"}),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("providerkey1", "providervalue1", "ignorekey"),
+					testAccObjectConfig_regionTags1(rName, acctest.AlternateRegion(), "Key1", "Value1Updated"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value1Updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key1", "Value1Updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"ignorekey1":   "ignorevalue1",
+						"Key1":         "Value1Updated",
+						"providerkey1": "providervalue1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_regionImport(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_region(rName, acctest.AlternateRegion()),
+			},
+			{
+				// The object's region is imported, so it's read from the bucket's region without region being configured.
+				Config:                  testAccObjectConfig_regionUnset(rName),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+				ImportStatePersist:      true,
+			},
+			{
+				Config:   testAccObjectConfig_regionUnset(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_useAccelerateEndpoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_useAccelerateEndpoint(rName, "Suspended"),
+				ExpectError: regexache.MustCompile(`use_accelerate_endpoint requires Transfer Acceleration to be enabled`),
+			},
+			{
+				Config: testAccObjectConfig_useAccelerateEndpoint(rName, "Enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "Hello World"),
+					resource.TestCheckResourceAttr(resourceName, "use_accelerate_endpoint", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_source(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	source := testAccObjectCreateTempFile(t, "{anything will do }")
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_source(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "{anything will do }"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy", "source"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceStream(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	if runtime.GOOS == "windows" {
+		t.Skip("streamed sources are read from /dev/fd")
+	}
+
+	// The provider runs in the test process, so the read end of the pipe is a stream that can only be read once.
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	go func() {
+		defer w.Close()
+		_, _ = io.WriteString(w, "{anything will do }")
+	}()
+
+	source := fmt.Sprintf("/dev/fd/%d", r.Fd())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_sourceStream(rName, source, 19),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "{anything will do }"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "19"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_content(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_content(rName, "some_bucket_content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64_sha256", "/pQvbkk6XLaLTuHn0VY0gbxEIw0GAGlZL43MC/E6ZVc="),
+					resource.TestCheckResourceAttr(resourceName, "content_sha256", "fe942f6e493a5cb68b4ee1e7d1563481bc44230d060069592f8dcc0bf13a6557"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_base64", "content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_ifNoneMatchETagExists(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_content(rName, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_ifNoneMatchETagExists(rName),
+				ExpectError: regexache.MustCompile(`the object already exists and if_none_match_etag is \*`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_contentSecret(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_contentSecret(rName, "some_bucket_content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "some_bucket_content"),
+					resource.TestCheckNoResourceAttr(resourceName, "content"),
+					resource.TestCheckResourceAttr(resourceName, "content_secret", "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "content_sha256", "fe942f6e493a5cb68b4ee1e7d1563481bc44230d060069592f8dcc0bf13a6557"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "3aa092e6f0fe468e376603aaeb32b5b8"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_contentSecret(rName, "changed_bucket_content"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("etag")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "changed_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "b56e86ba23c9e66d31825f4dbca6d185"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_secret", "content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceFromLocalFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	testExternalProviders := map[string]resource.ExternalProvider{
+		"local": {
+			Source:            "hashicorp/local",
+			VersionConstraint: "2.5.1",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders:        testExternalProviders,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The source file doesn't exist until local_file is created.
+				Config: testAccObjectConfig_sourceFromLocalFile(rName, "AAEC/w=="),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("content_length")),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("content_sha256")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "\x00\x01\x02\xff"),
+					resource.TestCheckNoResourceAttr(resourceName, "content_base64"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "4"),
+					resource.TestCheckResourceAttrPair(resourceName, "source", "local_file.test", "filename"),
+				),
+			},
+			{
+				// Replacing local_file makes source_hash unknown, so the new file is uploaded.
+				Config: testAccObjectConfig_sourceFromLocalFile(rName, "AAEC/v8="),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("content_length")),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("source_hash")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "\x00\x01\x02\xfe\xff"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_etagComputed(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_content(rName, "some_bucket_content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "etag", "3aa092e6f0fe468e376603aaeb32b5b8"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_content(rName, "changed_bucket_content"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("etag")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "etag", "b56e86ba23c9e66d31825f4dbca6d185"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_contentLength(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	source := testAccObjectCreateTempFile(t, "{anything will do }")
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_content(rName, "some_bucket_content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "19"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_contentBase64(rName, base64.StdEncoding.EncodeToString([]byte("stuff"))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "5"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_source(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "{anything will do }"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "19"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy", "source"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_etagEncryption(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectCreateTempFile(t, "{anything will do }")
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_etagEncryption(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "{anything will do }"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "7b006ff4d70f68cc65061acf2f802e6f"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy", "source"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_contentBase64(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_contentBase64(rName, base64.StdEncoding.EncodeToString([]byte("some_bucket_content"))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "some_bucket_content"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceHashTrigger(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj, updated_obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	startingData := "Ebben!"
+	changingData := "Ne andrò lontana"
+
+	filename := testAccObjectCreateTempFile(t, startingData)
+	defer os.Remove(filename)
+
+	rewriteFile := func(*terraform.State) error {
+		if err := os.WriteFile(filename, []byte(changingData), 0644); err != nil {
+			os.Remove(filename)
+			t.Fatal(err)
+		}
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_sourceHashTrigger(rName, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "Ebben!"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64_sha256", "QifZEbR25GUM80w73rZDli2WfdThYYLK2RjGoFGGuSo="),
+					resource.TestCheckResourceAttr(resourceName, "content_sha256", "4227d911b476e4650cf34c3bdeb643962d967dd4e16182cad918c6a05186b92a"),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "7c7e02a79f28968882bb1426c8f8bfc6"),
+					rewriteFile,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_sourceHashTrigger(rName, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &updated_obj),
+					testAccCheckObjectBody(&updated_obj, "Ne andrò lontana"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64_sha256", "q2j00Vv/exbSoCQcDv7g55LgR7IadJWPXb7vJlZdimU="),
+					resource.TestCheckResourceAttr(resourceName, "content_sha256", "ab68f4d15bff7b16d2a0241c0efee0e792e047b21a74958f5dbeef26565d8a65"),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "cffc5e20de2d21764145b1124c9b337b"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_base64", "content_base64_sha256", "content_sha256", "force_destroy", "source", "source_hash"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceHashMovedSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj, updated_obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	filename := testAccObjectCreateTempFile(t, "Ebben!")
+	defer os.Remove(filename)
+	movedFilename := filename + ".moved"
+	defer os.Remove(movedFilename)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_sourceHashTrigger(rName, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "Ebben!"),
+					resource.TestCheckResourceAttr(resourceName, "source", filename),
+				),
+			},
+			{
+				// Moving the source file doesn't change the object, as source_hash is unchanged.
+				PreConfig: func() {
+					if err := os.Rename(filename, movedFilename); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectConfig_sourceHashTrigger(rName, movedFilename),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// The content of the moved file is uploaded when it changes.
+				PreConfig: func() {
+					if err := os.WriteFile(movedFilename, []byte("Ne andrò lontana"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectConfig_sourceHashTrigger(rName, movedFilename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &updated_obj),
+					testAccCheckObjectBody(&updated_obj, "Ne andrò lontana"),
+					resource.TestCheckResourceAttr(resourceName, "source", movedFilename),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "cffc5e20de2d21764145b1124c9b337b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceHashTriggerSHA256(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj, updated_obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	startingData := "Ebben!"
+	changingData := "Ne andrò lontana"
+
+	filename := testAccObjectCreateTempFile(t, startingData)
+	defer os.Remove(filename)
+
+	rewriteFile := func(*terraform.State) error {
+		if err := os.WriteFile(filename, []byte(changingData), 0644); err != nil {
+			os.Remove(filename)
+			t.Fatal(err)
+		}
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_sourceHashTriggerSHA256(rName, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "Ebben!"),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "4227d911b476e4650cf34c3bdeb643962d967dd4e16182cad918c6a05186b92a"),
+					rewriteFile,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_sourceHashTriggerSHA256(rName, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &updated_obj),
+					testAccCheckObjectBody(&updated_obj, "Ne andrò lontana"),
+					resource.TestCheckResourceAttr(resourceName, "source_hash", "ab68f4d15bff7b16d2a0241c0efee0e792e047b21a74958f5dbeef26565d8a65"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_manageETagDisabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj, updated_obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	filename := testAccObjectCreateTempFile(t, "initial object state")
+	defer os.Remove(filename)
+
+	rewriteFile := func(*terraform.State) error {
+		if err := os.WriteFile(filename, []byte("modified object state"), 0644); err != nil {
+			os.Remove(filename)
+			t.Fatal(err)
+		}
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_manageETagDisabledWithETag(rName, filename),
+				ExpectError: regexache.MustCompile(`etag cannot be configured when manage_etag is false`),
+			},
+			{
+				Config: testAccObjectConfig_manageETagDisabled(rName, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "initial object state"),
+					resource.TestCheckResourceAttr(resourceName, "etag", ""),
+					resource.TestCheckResourceAttr(resourceName, "manage_etag", "false"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", "aws:kms"),
+					rewriteFile,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_manageETagDisabled(rName, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &updated_obj),
+					testAccCheckObjectBody(&updated_obj, "modified object state"),
+					testAccCheckObjectVersionIDDiffers(&updated_obj, &obj),
+					resource.TestCheckResourceAttr(resourceName, "etag", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_withContentCharacteristics(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	source := testAccObjectCreateTempFile(t, "{anything will do }")
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_contentCharacteristics(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "{anything will do }"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "binary/octet-stream"),
+					resource.TestCheckResourceAttr(resourceName, "website_redirect", "http://google.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_nonVersioned(t *testing.T) {
+	ctx := acctest.Context(t)
+	sourceInitial := testAccObjectCreateTempFile(t, "initial object state")
+	defer os.Remove(sourceInitial)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var originalObj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAssumeRoleARN(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_nonVersioned(rName, sourceInitial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &originalObj),
+					testAccCheckObjectBody(&originalObj, "initial object state"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy", "source"},
+				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_crossAccountBucketOwnerFullControl(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_crossAccountBucketOwnerFullControl(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "cross-account"),
+					resource.TestCheckResourceAttr(resourceName, "acl", string(types.ObjectCannedACLBucketOwnerFullControl)),
+					// With BucketOwnerPreferred, the bucket owner owns an object uploaded with bucket-owner-full-control.
+					testAccCheckObjectOwnedByBucketOwner(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_updates(t *testing.T) {
+	ctx := acctest.Context(t)
+	var originalObj, modifiedObj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	sourceInitial := testAccObjectCreateTempFile(t, "initial object state")
+	defer os.Remove(sourceInitial)
+	sourceModified := testAccObjectCreateTempFile(t, "modified object")
+	defer os.Remove(sourceInitial)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_updateable(rName, false, sourceInitial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &originalObj),
+					testAccCheckObjectBody(&originalObj, "initial object state"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "647d1d58e1011c743ec67d5e8af87b53"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updateable(rName, false, sourceModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &modifiedObj),
+					testAccCheckObjectBody(&modifiedObj, "modified object"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "1c7fd13df1515c2a13ad9eb068931f09"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy", "source"},
+				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_updateSameFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	var originalObj, modifiedObj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	startingData := "lane 8"
+	changingData := "chicane"
+
+	filename := testAccObjectCreateTempFile(t, startingData)
+	defer os.Remove(filename)
+
+	rewriteFile := func(*terraform.State) error {
+		if err := os.WriteFile(filename, []byte(changingData), 0644); err != nil {
+			os.Remove(filename)
+			t.Fatal(err)
+		}
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_updateable(rName, false, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &originalObj),
+					testAccCheckObjectBody(&originalObj, startingData),
+					resource.TestCheckResourceAttr(resourceName, "etag", "aa48b42f36a2652cbee40c30a5df7d25"),
+					rewriteFile,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_updateable(rName, false, filename),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &modifiedObj),
+					testAccCheckObjectBody(&modifiedObj, changingData),
+					resource.TestCheckResourceAttr(resourceName, "etag", "fafc05f8c4da0266a99154681ab86e8c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_etagUnversionedOverwriteWarning(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_etagOverwrite(rName, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "initial"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_etagOverwrite(rName, "updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectBody(&obj2, "updated"),
+					testAccCheckObjectUnversionedOverwriteWarning(ctx, resourceName, fmt.Sprintf("S3 Bucket (%s) versioning is Disabled, so updating Object (test-key) overwrites its current content.", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_etagVersionedOverwriteNoWarning(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_etagOverwriteVersioned(rName, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "initial"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_etagOverwriteVersioned(rName, "updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectBody(&obj2, "updated"),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+					testAccCheckObjectUnversionedOverwriteWarning(ctx, resourceName, ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_updatesWithVersioning(t *testing.T) {
+	ctx := acctest.Context(t)
+	var originalObj, modifiedObj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	sourceInitial := testAccObjectCreateTempFile(t, "initial versioned object state")
+	defer os.Remove(sourceInitial)
+	sourceModified := testAccObjectCreateTempFile(t, "modified versioned object")
+	defer os.Remove(sourceInitial)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_updateable(rName, true, sourceInitial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &originalObj),
+					testAccCheckObjectBody(&originalObj, "initial versioned object state"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "cee4407fa91906284e2a5e5e03e86b1b"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updateable(rName, true, sourceModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &modifiedObj),
+					testAccCheckObjectBody(&modifiedObj, "modified versioned object"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "00b8c73b1b50e7cc932362c7225b8e29"),
+					testAccCheckObjectVersionIDDiffers(&modifiedObj, &originalObj),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy", "source"},
+				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_maxVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_maxVersions(rName, "version 1", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "version_ids.0", resourceName, "version_id"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_maxVersions(rName, "version 2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "version_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "version_ids.0", resourceName, "version_id"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_maxVersions(rName, "version 3", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "version_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "version_ids.0", resourceName, "version_id"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_maxVersions(rName, "version 3", 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "version_ids.#", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "version_ids.0", resourceName, "version_id"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_maxVersions(rName, "version 3", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "version_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_updatesWithVersioningViaAccessPoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	var originalObj, modifiedObj s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	accessPointResourceName := "aws_s3_access_point.test"
+
+	sourceInitial := testAccObjectCreateTempFile(t, "initial versioned object state")
+	defer os.Remove(sourceInitial)
+	sourceModified := testAccObjectCreateTempFile(t, "modified versioned object")
+	defer os.Remove(sourceInitial)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_updateableViaAccessPoint(rName, sourceInitial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &originalObj),
+					testAccCheckObjectBody(&originalObj, "initial versioned object state"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "s3", fmt.Sprintf("accesspoint/%s/object/updateable-key", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "cee4407fa91906284e2a5e5e03e86b1b"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updateableViaAccessPoint(rName, sourceModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &modifiedObj),
+					testAccCheckObjectBody(&modifiedObj, "modified versioned object"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "00b8c73b1b50e7cc932362c7225b8e29"),
+					testAccCheckObjectVersionIDDiffers(&modifiedObj, &originalObj),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_updatesWithVersioningViaMultiRegionAccessPoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	var originalObj, modifiedObj s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.test"
+	accessPointResourceName := "aws_s3control_multi_region_access_point.test"
+
+	sourceInitial := testAccObjectCreateTempFile(t, "initial versioned object state")
+	defer os.Remove(sourceInitial)
+	sourceModified := testAccObjectCreateTempFile(t, "modified versioned object")
+	defer os.Remove(sourceModified)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, names.ChinaPartitionID, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop, // Cannot access the object via the access point alias after the access point is destroyed
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_updateableViaMultiRegionAccessPoint(rName, sourceInitial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &originalObj),
+					testAccCheckObjectBody(&originalObj, "initial versioned object state"),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", accessPointResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "cee4407fa91906284e2a5e5e03e86b1b"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updateableViaMultiRegionAccessPoint(rName, sourceModified),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &modifiedObj),
+					testAccCheckObjectBody(&modifiedObj, "modified versioned object"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "00b8c73b1b50e7cc932362c7225b8e29"),
+					testAccCheckObjectVersionIDDiffers(&modifiedObj, &originalObj),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_kms(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	source := testAccObjectCreateTempFile(t, "{anything will do }")
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_kmsID(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectSSE(ctx, resourceName, "aws:kms"),
+					testAccCheckObjectBody(&obj, "{anything will do }"),
+					// The ETag of a KMS encrypted object isn't an MD5 digest of the object content.
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy", "source"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_kmsAlias(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	kmsKeyResourceName := "aws_kms_key.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_kmsAlias(rName, "name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectSSE(ctx, resourceName, "aws:kms"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_kmsAlias(rName, "arn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", kmsKeyResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sse(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	source := testAccObjectCreateTempFile(t, "{anything will do }")
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_sse(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectSSE(ctx, resourceName, "AES256"),
+					testAccCheckObjectBody(&obj, "{anything will do }"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy", "source"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_acl(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_acl(rName, "some_bucket_content", string(types.BucketCannedACLPrivate), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "acl", string(types.BucketCannedACLPrivate)),
+					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL"}),
+				),
+			},
+			{
+				Config: testAccObjectConfig_acl(rName, "some_bucket_content", string(types.BucketCannedACLPublicRead), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "acl", string(types.BucketCannedACLPublicRead)),
+					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL", "READ"}),
+				),
+			},
+			{
+				Config: testAccObjectConfig_acl(rName, "changed_some_bucket_content", string(types.BucketCannedACLPrivate), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "changed_some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "acl", string(types.BucketCannedACLPrivate)),
+					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL"}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "content", "content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_accessControlPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_accessControlPolicy(rName, "some_bucket_content", "READ"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "access_control_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_control_policy.0.grant.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_policy.0.grant.*", map[string]string{
+						"grantee.#":      "1",
+						"grantee.0.type": string(types.TypeGroup),
+						"grantee.0.uri":  "http://acs.amazonaws.com/groups/s3/LogDelivery",
+						"permission":     string(types.PermissionWriteAcp),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_policy.0.grant.*", map[string]string{
+						"grantee.#":      "1",
+						"grantee.0.type": string(types.TypeCanonicalUser),
+						"permission":     string(types.PermissionRead),
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "access_control_policy.0.owner.0.id", "data.aws_canonical_user_id.current", "id"),
+					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL", "READ", "WRITE_ACP"}),
+				),
+			},
+			{
+				Config: testAccObjectConfig_accessControlPolicy(rName, "some_bucket_content", "READ_ACP"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "access_control_policy.0.grant.#", "3"),
+					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL", "READ_ACP", "WRITE_ACP"}),
+				),
+			},
+			{
+				Config: testAccObjectConfig_accessControlPolicy(rName, "changed_some_bucket_content", "READ_ACP"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "changed_some_bucket_content"),
+					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL", "READ_ACP", "WRITE_ACP"}),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_accessControlPolicyAndACL(rName),
+				ExpectError: regexache.MustCompile(`"access_control_policy": conflicts with acl`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_accessControlPolicyLogDeliveryGroup(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_accessControlPolicyLogDeliveryGroup(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "access_control_policy.0.grant.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_policy.0.grant.*", map[string]string{
+						"grantee.#":      "1",
+						"grantee.0.type": string(types.TypeGroup),
+						"grantee.0.uri":  "http://acs.amazonaws.com/groups/s3/LogDelivery",
+						"permission":     string(types.PermissionReadAcp),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_policy.0.grant.*", map[string]string{
+						"grantee.#":      "1",
+						"grantee.0.type": string(types.TypeGroup),
+						"grantee.0.uri":  "http://acs.amazonaws.com/groups/s3/LogDelivery",
+						"permission":     string(types.PermissionWriteAcp),
+					}),
+					testAccCheckObjectACL(ctx, resourceName, []string{"FULL_CONTROL", "READ_ACP", "WRITE_ACP"}),
+				),
+			},
+			{
+				// The group grants read back via GetObjectAcl match the configuration.
+				Config: testAccObjectConfig_accessControlPolicyLogDeliveryGroup(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3Object_grants(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_grants(rName, "grant_read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectGrants(ctx, resourceName, []string{"FULL_CONTROL", "READ"}),
+					resource.TestCheckResourceAttr(resourceName, "grant_full_control.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grant_read.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grant_read_acp.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "grant_write_acp.#", "0"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccObjectConfig_grants(rName, "grant_read_acp"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectGrants(ctx, resourceName, []string{"FULL_CONTROL", "READ_ACP"}),
+					resource.TestCheckResourceAttr(resourceName, "grant_full_control.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grant_read.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "grant_read_acp.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grant_write_acp.#", "0"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccObjectConfig_acl(rName, "some_bucket_content", string(types.BucketCannedACLPrivate), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectGrants(ctx, resourceName, []string{"FULL_CONTROL"}),
+					resource.TestCheckResourceAttr(resourceName, "grant_full_control.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "grant_read_acp.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_aclBucketOwnerEnforced(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_aclBucketOwnerEnforced(rName, "authenticated-read"),
+				ExpectError: regexache.MustCompile(`the bucket's object ownership is BucketOwnerEnforced, which disables ACLs, remove acl and access_control_policy`),
+			},
+			{
+				// The bucket-owner-full-control canned ACL is accepted when ACLs are disabled.
+				Config: testAccObjectConfig_aclBucketOwnerEnforced(rName, "bucket-owner-full-control"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "acl", "bucket-owner-full-control"),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_aclBucketOwnerEnforced(rName, "authenticated-read"),
+				ExpectError: regexache.MustCompile(`the bucket's object ownership is BucketOwnerEnforced, which disables ACLs, remove acl and access_control_policy`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_aclObjectOwnership(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_aclObjectOwnership(rName, "ObjectWriter", "private"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "acl", "private"),
+				),
+			},
+			{
+				// The ACL is validated against the existing bucket's ownership during plan.
+				Config: testAccObjectConfig_aclObjectOwnership(rName, "ObjectWriter", "bucket-owner-read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "acl", "bucket-owner-read"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_aclObjectOwnership(rName, "BucketOwnerPreferred", "bucket-owner-read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+				),
+			},
+			{
+				Config: testAccObjectConfig_aclObjectOwnership(rName, "BucketOwnerPreferred", "private"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "acl", "private"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_aclObjectOwnership(rName, "BucketOwnerEnforced", "private"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_aclObjectOwnership(rName, "BucketOwnerEnforced", "bucket-owner-read"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`acl "bucket-owner-read" can't be applied, the bucket's object ownership is BucketOwnerEnforced`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_metadata(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_metadata(rName, "key1", "value1", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_metadata(rName, "key1", "value1updated", "key3", "value3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key3", "value3"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_expires(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_expires(rName, "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "expires", "Thu, 01 Jan 2099 00:00:00 GMT"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_expires(rName, "Fri, 01 Jan 2100 00:00:00 GMT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "expires", "Fri, 01 Jan 2100 00:00:00 GMT"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config:      testAccObjectConfig_expires(rName, "2100-01-01"),
+				ExpectError: regexache.MustCompile(`is not in RFC1123 or RFC3339 format`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_storageClass(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_content(rName, "some_bucket_content"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
+					testAccCheckObjectStorageClass(ctx, resourceName, "STANDARD"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_storageClass(rName, "REDUCED_REDUNDANCY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "REDUCED_REDUNDANCY"),
+					testAccCheckObjectStorageClass(ctx, resourceName, "REDUCED_REDUNDANCY"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_storageClass(rName, "GLACIER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Can't GetObject on an object in Glacier without restoring it.
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "GLACIER"),
+					testAccCheckObjectStorageClass(ctx, resourceName, "GLACIER"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_storageClass(rName, "INTELLIGENT_TIERING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "INTELLIGENT_TIERING"),
+					testAccCheckObjectStorageClass(ctx, resourceName, "INTELLIGENT_TIERING"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_storageClass(rName, "DEEP_ARCHIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// 	Can't GetObject on an object in DEEP_ARCHIVE without restoring it.
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "DEEP_ARCHIVE"),
+					testAccCheckObjectStorageClass(ctx, resourceName, "DEEP_ARCHIVE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	key := "test-key"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updatedTags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_noTags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_tags(rName, key, "changed stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj4),
+					testAccCheckObjectVersionIDDiffers(&obj4, &obj3),
+					testAccCheckObjectBody(&obj4, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_refreshMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_refreshMode(rName, "head_only"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "refresh_mode", "head_only"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					// Tags are listed whatever the refresh mode, so tags added outside of Terraform are detected.
+					testAccCheckObjectUpdateTags(ctx, resourceName, nil, map[string]string{"externalkey1": "externalvalue1"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_refreshMode(rName, "none"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"Key1": "Value1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "refresh_mode", "none"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					// Tags are listed whatever the refresh mode, so tags added outside of Terraform are detected.
+					testAccCheckObjectUpdateTags(ctx, resourceName, nil, map[string]string{"externalkey1": "externalvalue1"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_refreshMode(rName, "full"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"Key1": "Value1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "refresh_mode", "full"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	key := "test-key"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectCheckVersionTags(ctx, resourceName, &obj1, map[string]string{
+						"Key1": "A@AA",
+						"Key2": "BBB",
+						"Key3": "CCC",
+					}),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updatedTags(rName, key, "changed stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+					testAccCheckObjectCheckVersionTags(ctx, resourceName, &obj1, map[string]string{
+						"Key1": "A@AA",
+						"Key2": "BBB",
+						"Key3": "CCC",
+					}),
+					testAccCheckObjectCheckVersionTags(ctx, resourceName, &obj2, map[string]string{
+						"Key2": "B@BB",
+						"Key3": "X X",
+						"Key4": "DDD",
+						"Key5": "E:/",
+					}),
+				),
+			},
+			{
+				Config: testAccObjectConfig_tags(rName, key, "changed stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
+					testAccCheckObjectCheckVersionTags(ctx, resourceName, &obj1, map[string]string{
+						"Key1": "A@AA",
+						"Key2": "BBB",
+						"Key3": "CCC",
+					}),
+					testAccCheckObjectCheckVersionTags(ctx, resourceName, &obj3, map[string]string{
+						"Key1": "A@AA",
+						"Key2": "BBB",
+						"Key3": "CCC",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_storageClassInPlace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_storageClass(rName, "STANDARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "etag", "3aa092e6f0fe468e376603aaeb32b5b8"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
+					testAccCheckObjectStorageClass(ctx, resourceName, "STANDARD"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_storageClass(rName, "STANDARD_IA"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "etag", "3aa092e6f0fe468e376603aaeb32b5b8"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD_IA"),
+					testAccCheckObjectStorageClass(ctx, resourceName, "STANDARD_IA"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsLeadingSingleSlash(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.object"
+	key := "/test-key"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					// The provider removes leading slashes from the key and collapses repeated slashes, see sdkv1CompatibleCleanKey.
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", fmt.Sprintf("%s/test-key", rName)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updatedTags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_noTags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_tags(rName, key, "changed stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj4),
+					testAccCheckObjectVersionIDDiffers(&obj4, &obj3),
+					testAccCheckObjectBody(&obj4, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsLeadingMultipleSlashes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.object"
+	key := "/////test-key"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", fmt.Sprintf("%s/test-key", rName)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updatedTags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_noTags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_tags(rName, key, "changed stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj4),
+					testAccCheckObjectVersionIDDiffers(&obj4, &obj3),
+					testAccCheckObjectBody(&obj4, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsMultipleSlashes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.object"
+	key := "first//second///third//"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", fmt.Sprintf("%s/first/second/third/", rName)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updatedTags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_noTags(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_tags(rName, key, "changed stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj4),
+					testAccCheckObjectVersionIDDiffers(&obj4, &obj3),
+					testAccCheckObjectBody(&obj4, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tags_EmptyTag_OnCreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := "test-key"
+	resourceName := "aws_s3_object.object"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tags1(rName, key, "key1", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := "test-key"
+	resourceName := "aws_s3_object.object"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tags1(rName, key, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_tags2(rName, key, "key1", "value1", "key2", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := "test-key"
+	resourceName := "aws_s3_object.object"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tags1(rName, key, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_tags1(rName, key, "key1", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_DefaultTags_withResourceTagsAndIgnoreTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := "test-key"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("providerkey1", "providervalue1", "ignorekey"),
+					testAccObjectConfig_contentTags2(rName, key, "stuff", "providerkey1", "providervalue1", "resourcekey1", "resourcevalue1"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.resourcekey1", "resourcevalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.resourcekey1", "resourcevalue1"),
+					testAccCheckObjectUpdateTags(ctx, resourceName, nil, map[string]string{"ignorekey1": "ignorevalue1"}),
+				),
+			},
+			{
+				// A new object version is uploaded.
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("providerkey1", "providervalue1", "ignorekey"),
+					testAccObjectConfig_contentTags2(rName, key, "changed stuff", "providerkey1", "providervalue1", "resourcekey1", "resourcevalue1"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"ignorekey1":   "ignorevalue1",
+						"providerkey1": "providervalue1",
+						"resourcekey1": "resourcevalue1",
+					}),
+				),
+			},
+			{
+				// Only the tags are updated.
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultAndIgnoreTagsKeyPrefixes1("providerkey1", "providervalue1", "ignorekey"),
+					testAccObjectConfig_contentTags2(rName, key, "changed stuff", "providerkey1", "resourcevalue2", "resourcekey1", "resourcevalue1"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.providerkey1", "resourcevalue2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "resourcevalue2"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"ignorekey1":   "ignorevalue1",
+						"providerkey1": "resourcevalue2",
+						"resourcekey1": "resourcevalue1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsExternal(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := "test-key"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// A tag that isn't ignored is added outside of Terraform, and shows as a difference.
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
+					testAccObjectConfig_tags(rName, key, "stuff")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectUpdateTags(ctx, resourceName, nil, map[string]string{"externalkey1": "externalvalue1", "ignorekey1": "ignorevalue1"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The tag is removed from the object's current version, and the ignored tag is kept.
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
+					testAccObjectConfig_tags(rName, key, "stuff")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"ignorekey1": "ignorevalue1",
+						"Key1":       "A@AA",
+						"Key2":       "BBB",
+						"Key3":       "CCC",
+					}),
+					testAccCheckObjectUpdateTags(ctx, resourceName, nil, map[string]string{"externalkey2": "externalvalue2"}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The tag is also removed when a new object version is uploaded.
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
+					testAccObjectConfig_tags(rName, key, "changed stuff")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"ignorekey1": "ignorevalue1",
+						"Key1":       "A@AA",
+						"Key2":       "BBB",
+						"Key3":       "CCC",
+					}),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
+					testAccObjectConfig_tags(rName, key, "changed stuff")),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3Object_mergeExistingTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_mergeExistingTags1(rName, "stuff", "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "merge_existing_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					testAccCheckObjectUpdateTags(ctx, resourceName, nil, map[string]string{"externalkey1": "externalvalue1", "externalkey2": "externalvalue2"}),
+				),
+			},
+			{
+				// A new object version is uploaded.
+				Config: testAccObjectConfig_mergeExistingTags1(rName, "changed stuff", "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"externalkey1": "externalvalue1",
+						"externalkey2": "externalvalue2",
+						"key1":         "value1",
+					}),
+				),
+			},
+			{
+				// Configured tags take precedence over existing tags.
+				Config: testAccObjectConfig_mergeExistingTags2(rName, "more stuff", "key1", "value1", "externalkey1", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "more stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.externalkey1", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"externalkey1": "value2",
+						"externalkey2": "externalvalue2",
+						"key1":         "value1",
+					}),
+				),
+			},
+			{
+				// Tags no longer configured are removed.
+				Config: testAccObjectConfig_mergeExistingTags1(rName, "even more stuff", "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "even more stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"externalkey2": "externalvalue2",
+						"key1":         "value1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_DefaultTags_providerOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectConfig_basic(rName),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_DefaultTags_providerAndResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	key := "test-key"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectConfig_tags(rName, key, "stuff"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key3", "CCC"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectConfig_updatedTags(rName, key, "stuff"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "5"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key5", "E:/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_DefaultTags_providerAndResourceWithOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	key := "test-key"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectConfig_tagsWithOverride(rName, key, "stuff"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key3", "CCC"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectConfig_updatedTagsWithOverride(rName, key, "stuff"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Key5", "E:/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsViaAccessPointARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	key := "test-key"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tagsViaAccessPointARN(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updatedTagsViaAccessPointARN(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsViaAccessPointAlias(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	const resourceName = "aws_s3_object.object"
+	const key = "test-key"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop, // Cannot access the object via the access point alias after the access point is destroyed
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tagsViaAccessPointAlias(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					acctest.MatchResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", regexache.MustCompile(fmt.Sprintf(`%s-\w+-s3alias/%s`, rName[:20], key))),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updatedTagsViaAccessPointAlias(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsViaMultiRegionAccessPoint(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	key := "test-key"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, names.ChinaPartitionID, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop, // Cannot access the object via the access point alias after the access point is destroyed
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tagsViaMultiRegionAccessPoint(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_updatedTagsViaMultiRegionAccessPoint(rName, key, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsViaObjectLambdaAccessPointARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "test-key"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_tagsViaObjectLambdaAccessPointARN(rName, key, "stuff"),
+				ExpectError: regexache.MustCompile(`S3 Object Lambda access point \(.+\) does not support writing objects`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_objectLockLegalHoldStartWithNone(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_noLockLegalHold(rName, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+			{
+				Config: testAccObjectConfig_lockLegalHold(rName, "stuff", "ON"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+			// Remove legal hold but create a new object version to test force_destroy
+			{
+				Config: testAccObjectConfig_lockLegalHold(rName, "changed stuff", "OFF"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_objectLockLegalHoldStartWithOn(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_lockLegalHold(rName, "stuff", "ON"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+			{
+				Config: testAccObjectConfig_lockLegalHold(rName, "stuff", "OFF"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_forceDestroyBypassLegalHold(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_forceDestroyBypassLegalHold(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy_bypass_legal_hold", "true"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_forceDestroyAbortsMultipartUploads(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	bucketResourceName := "aws_s3_bucket.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_forceDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+					// Start a multipart upload of the object outside of Terraform, as left by an interrupted upload.
+					testAccCheckObjectCreateMultipartUpload(ctx, resourceName),
+				),
+			},
+			{
+				Config: testAccBucketConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectNoMultipartUploads(ctx, bucketResourceName, "test-key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_forceDestroyBypassGovernanceRetention(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	retainUntilDate := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_forceDestroyBypassGovernanceRetention(rName, retainUntilDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy_bypass_governance_retention", "true"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_keyContentSHA256(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	hash1, hash2 := sha256.Sum256([]byte("initial")), sha256.Sum256([]byte("updated"))
+	key1, key2 := fmt.Sprintf("assets/%x.txt", hash1), fmt.Sprintf("assets/%x.txt", hash2)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_keyContentSHA256(rName, "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "initial"),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", fmt.Sprintf("%s/%s", rName, key1)),
+					resource.TestCheckResourceAttr(resourceName, "content_sha256", fmt.Sprintf("%x", hash1)),
+					resource.TestCheckResourceAttr(resourceName, "id", key1),
+					resource.TestCheckResourceAttr(resourceName, "key", "assets/${content_sha256}.txt"),
+				),
+			},
+			// The object is imported with its resolved key, which replaces the configured key in state.
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", rName, key1),
+				ImportStateVerify: true,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 imported state, got %d", len(s))
+					}
+
+					if got, want := s[0].Attributes["key"], key1; got != want {
+						return fmt.Errorf("imported key = %s, want %s", got, want)
+					}
+
+					return nil
+				},
+				ImportStateVerifyIgnore: []string{"content", "content_base64_sha256", "content_sha256", "force_destroy", "key"},
+			},
+			{
+				Config: testAccObjectConfig_keyContentSHA256(rName, "updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectBody(&obj2, "updated"),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", fmt.Sprintf("%s/%s", rName, key2)),
+					resource.TestCheckResourceAttr(resourceName, "content_sha256", fmt.Sprintf("%x", hash2)),
+					resource.TestCheckResourceAttr(resourceName, "id", key2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_bypassGovernanceRetentionConfirmation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	retainUntilDate := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_bypassGovernanceRetentionConfirmation(rName, retainUntilDate, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckNoResourceAttr(resourceName, "bypass_governance_retention_confirmation"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_bypassGovernanceRetentionConfirmation(rName, retainUntilDate, ""),
+				Destroy:     true,
+				ExpectError: regexache.MustCompile(`bypass_governance_retention_confirmation`),
+			},
+			{
+				Config: testAccObjectConfig_bypassGovernanceRetentionConfirmation(rName, retainUntilDate, "bypass-governance-retention"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "bypass_governance_retention_confirmation", "bypass-governance-retention"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy_bypass_governance_retention", "false"),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_bypassGovernanceRetentionConfirmation(rName, retainUntilDate, "true"),
+				ExpectError: regexache.MustCompile(`expected bypass_governance_retention_confirmation`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_objectLockRetentionStartWithNone(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	retainUntilDate := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_noLockRetention(rName, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+			{
+				Config: testAccObjectConfig_lockRetention(rName, "stuff", retainUntilDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_inherited", "false"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+			},
+			// Remove retention period but create a new object version to test force_destroy
+			{
+				Config: testAccObjectConfig_noLockRetention(rName, "changed stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_objectLockRetentionStartWithSet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	retainUntilDate1 := time.Now().UTC().AddDate(0, 0, 20).Format(time.RFC3339)
+	retainUntilDate2 := time.Now().UTC().AddDate(0, 0, 30).Format(time.RFC3339)
+	retainUntilDate3 := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_lockRetention(rName, "stuff", retainUntilDate1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate1),
+				),
+			},
+			{
+				Config: testAccObjectConfig_lockRetention(rName, "stuff", retainUntilDate2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate2),
+				),
+			},
+			{
+				Config: testAccObjectConfig_lockRetention(rName, "stuff", retainUntilDate3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate3),
+				),
+			},
+			{
+				Config: testAccObjectConfig_noLockRetention(rName, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj4),
+					testAccCheckObjectVersionIDEquals(&obj4, &obj3),
+					testAccCheckObjectBody(&obj4, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_objectLockLegalHoldAndRetention(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	retainUntilDate := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_lockLegalHoldAndRetention(rName, "GOVERNANCE", ""),
+				ExpectError: regexache.MustCompile("all of `object_lock_mode,object_lock_retain_until_date` must be specified"),
+			},
+			{
+				Config: testAccObjectConfig_lockLegalHoldAndRetention(rName, "GOVERNANCE", retainUntilDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "stuff"),
+					testAccCheckObjectVersionCount(ctx, rName, "test-key", 1, 0),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_inherited", "false"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3Object_objectLockRetentionInheritedFromBucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	retainUntilDate := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_lockRetentionBucketDefault(rName, "stuff", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_inherited", "true"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttrSet(resourceName, "object_lock_retain_until_date"),
+				),
+			},
+			{
+				// The inherited retention doesn't cause a difference.
+				Config:   testAccObjectConfig_lockRetentionBucketDefault(rName, "stuff", ""),
+				PlanOnly: true,
+			},
+			{
+				// Configured retention takes precedence over the bucket's default retention.
+				Config: testAccObjectConfig_lockRetentionBucketDefault(rName, "stuff", retainUntilDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_inherited", "false"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
+				),
+			},
+			{
+				// A new object version inherits the bucket's default retention.
+				Config: testAccObjectConfig_lockRetentionBucketDefault(rName, "changed stuff", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_inherited", "true"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
+					resource.TestCheckResourceAttrSet(resourceName, "object_lock_retain_until_date"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_objectBucketKeyEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_bucketKeyEnabled(rName, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_bucketBucketKeyEnabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_bucketBucketKeyEnabled(rName, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_bucketBucketKeyEnabledInherited(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_bucketBucketKeyEnabled(rName, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "true"),
+				),
+			},
+			{
+				Config:   testAccObjectConfig_bucketBucketKeyEnabled(rName, "stuff"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccObjectConfig_bucketBucketKeyEnabled(rName, "changed stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "changed stuff"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_bucketBucketKeyEnabledOverride(rName, "changed stuff", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "false"),
+				),
+			},
+			{
+				Config:   testAccObjectConfig_bucketBucketKeyEnabledOverride(rName, "changed stuff", false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_defaultBucketSSE(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_defaultBucketSSE(rName, "stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", "aws:kms"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_defaultBucketSSE(rName, "changed stuff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "changed stuff"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", "aws:kms"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_ignoreTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object.object"
+	key := "test-key"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
+					testAccObjectConfig_noTags(rName, key, "stuff")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "stuff"),
+					testAccCheckObjectUpdateTags(ctx, resourceName, nil, map[string]string{"ignorekey1": "ignorevalue1"}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"ignorekey1": "ignorevalue1",
+					}),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
+					testAccObjectConfig_tags(rName, key, "stuff")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"ignorekey1": "ignorevalue1",
+						"Key1":       "A@AA",
+						"Key2":       "BBB",
+						"Key3":       "CCC",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_checksumAlgorithm(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "CRC32"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", "q/d4Ig=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"checksum_algorithm", "checksum_crc32", "content", "content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "SHA256"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
+				),
+			},
+		},
+	})
+}
+
+// The S3 client only calculates and validates checksums when required, so objects written by
+// aws_s3_bucket_object and aws_s3_object without checksum_algorithm carry no additional checksum.
+func TestAccS3Object_defaultChecksumsMatchBucketObject(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	bucketObjectResourceName := "aws_s3_bucket_object.object"
+	dataSourceName := "data.aws_s3_object.object"
+	bucketObjectDataSourceName := "data.aws_s3_object.bucket_object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_defaultChecksumsMatchBucketObject(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "etag", bucketObjectResourceName, "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "checksum_sha256", ""),
+					resource.TestCheckResourceAttr(bucketObjectDataSourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(bucketObjectDataSourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(bucketObjectDataSourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(bucketObjectDataSourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_providerDefaultChecksumAlgorithm(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_content(rName, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+			{
+				// An object uploaded without a checksum doesn't have a difference.
+				Config: testAccObjectConfig_providerDefaultChecksumAlgorithm(rName, "SHA256", "ABCDEFGHIJKLMNOPQRSTUVWXYZ", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+			{
+				Config: testAccObjectConfig_providerDefaultChecksumAlgorithm(rName, "SHA256", "changed", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "changed"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1n4ulEmUSWyNjsdu7Qz58JZ5RI1YS1Mr6/lBhSo39e0="),
+				),
+			},
+			{
+				// The resource's checksum_algorithm takes precedence over the provider's default.
+				Config: testAccObjectConfig_providerDefaultChecksumAlgorithm(rName, "SHA256", "changed", "CRC32"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", "o/M9+g=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_checksumAlgorithmDetectsContentChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "SHA256"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
+					resource.TestCheckResourceAttrPair(resourceName, "content_base64_sha256", resourceName, "checksum_sha256"),
+					testAccCheckObjectPutContent(ctx, resourceName, "changed outside of Terraform"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "SHA256"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
+					resource.TestCheckResourceAttrPair(resourceName, "content_base64_sha256", resourceName, "checksum_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_checksumType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// Larger than the upload manager's default part size, so that the object is uploaded in 2 parts.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("A", 6*1024*1024))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_checksumType(rName, source, "SHA256", "FULL_OBJECT"),
+				ExpectError: regexache.MustCompile(`checksum_type FULL_OBJECT is not supported with checksum_algorithm SHA256`),
+			},
+			{
+				Config: testAccObjectConfig_checksumType(rName, source, "CRC64NVME", "FULL_OBJECT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC64NVME"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum_crc64nvme"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_type", "FULL_OBJECT"),
+					resource.TestCheckResourceAttr(resourceName, "parts_count", "2"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_checksumType(rName, source, "CRC32", "COMPOSITE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum_crc32"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_type", "COMPOSITE"),
+					resource.TestCheckResourceAttr(resourceName, "parts_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_checksumTypeSinglePart(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	source := testAccObjectCreateTempFile(t, "ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// S3 reports a full object checksum for objects uploaded in a single part.
+				Config: testAccObjectConfig_checksumType(rName, source, "CRC32", "COMPOSITE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", "q/d4Ig=="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_type", "FULL_OBJECT"),
+					resource.TestCheckResourceAttr(resourceName, "parts_count", "0"),
+				),
+			},
+			{
+				Config:   testAccObjectConfig_checksumType(rName, source, "CRC32", "COMPOSITE"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_verifyChecksum(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_verifyChecksum(rName, "null"),
+				ExpectError: regexache.MustCompile(`verify_checksum requires checksum_algorithm to be set`),
+			},
+			{
+				Config: testAccObjectConfig_verifyChecksum(rName, `"SHA256"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
+					resource.TestCheckResourceAttr(resourceName, "verify_checksum", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_detectContentType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_detectContentType(rName, "test-key.json", "{}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "detect_content_type", "true"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_detectContentType(rName, "test-key", "<html><body></body></html>"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/html; charset=utf-8"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_base64_sha256", "content_sha256", "detect_content_type", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_contentTypeReference(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	dependentResourceName := "aws_s3_object.dependent"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_contentTypeReference(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("content_type")),
+						plancheck.ExpectUnknownValue(dependentResourceName, tfjsonpath.New("cache_control")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
+					resource.TestCheckResourceAttr(dependentResourceName, "cache_control", "no-store"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_contentTypeReference(rName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("content_type"), knownvalue.StringExact("application/json")),
+						plancheck.ExpectKnownValue(dependentResourceName, tfjsonpath.New("cache_control"), knownvalue.StringExact("max-age=300")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(dependentResourceName, "cache_control", "max-age=300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_contentTypeDefaultMultipart(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// Larger than the uploader's part size, so the object is uploaded in two parts.
+	data := strings.Repeat("0123456789abcdef", 6*1024*1024/16)
+	source := testAccObjectCreateTempFile(t, data)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_sourceNoContentType(rName, source),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("content_type")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					// Unlike PutObject, CreateMultipartUpload has no default content type, so S3 stores its own default.
+					resource.TestCheckResourceAttr(resourceName, "content_type", "binary/octet-stream"),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`-2$`)),
+					resource.TestCheckResourceAttr(resourceName, "parts_count", "2"),
+				),
+			},
+			{
+				Config:   testAccObjectConfig_sourceNoContentType(rName, source),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_contentBase64HashOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_contentBase64HashOnly(rName, base64.StdEncoding.EncodeToString([]byte("some_bucket_content")), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64", base64.StdEncoding.EncodeToString([]byte("some_bucket_content"))),
+					resource.TestCheckResourceAttr(resourceName, "content_base64_hash_only", "false"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64_sha256", "/pQvbkk6XLaLTuHn0VY0gbxEIw0GAGlZL43MC/E6ZVc="),
+				),
+			},
+			{
+				Config: testAccObjectConfig_contentBase64HashOnly(rName, base64.StdEncoding.EncodeToString([]byte("some_bucket_content")), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "some_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64", ""),
+					resource.TestCheckResourceAttr(resourceName, "content_base64_hash_only", "true"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64_sha256", "/pQvbkk6XLaLTuHn0VY0gbxEIw0GAGlZL43MC/E6ZVc="),
+				),
+			},
+			{
+				Config:   testAccObjectConfig_contentBase64HashOnly(rName, base64.StdEncoding.EncodeToString([]byte("some_bucket_content")), true),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccObjectConfig_contentBase64HashOnly(rName, base64.StdEncoding.EncodeToString([]byte("changed_bucket_content")), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "changed_bucket_content"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "content_base64_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceBucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_sourceBucket(rName, "COPY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "source content"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "14"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_directive", "COPY"),
+					resource.TestCheckResourceAttr(resourceName, "source_key", "source-key"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				Config: testAccObjectConfig_sourceBucket(rName, "REPLACE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "source content"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.copied", "true"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_directive", "REPLACE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_keySpecialCharacters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	copyResourceName := "aws_s3_object.copy"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := "a+b c%20d/été 😀.txt"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_keySpecialCharacters(rName, key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "special"),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", fmt.Sprintf("%s/%s", rName, key)),
+					resource.TestCheckResourceAttr(resourceName, "id", key),
+					resource.TestCheckResourceAttr(resourceName, "key", key),
+					resource.TestCheckResourceAttr(resourceName, "object_url", fmt.Sprintf("https://%s/a%%2Bb%%20c%%2520d/%%C3%%A9t%%C3%%A9%%20%%F0%%9F%%98%%80.txt", testAccBucketRegionalDomainName(rName, acctest.Region()))),
+					// The copy source isn't read as "a b c d/...", or "a+b c%20d/..." decoded once.
+					testAccCheckObjectExists(ctx, copyResourceName, &obj),
+					testAccCheckObjectBody(&obj, "special"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content", "content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceBucketTaggingDirective(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The source object's tags are replaced in the same CopyObject call.
+				Config: testAccObjectConfig_sourceBucketTaggingDirective(rName, "REPLACE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "source content"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"Name": rName,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tagging_directive", "REPLACE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				Config: testAccObjectConfig_sourceBucketTaggingDirective(rName, "COPY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "source content"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"Source": "true",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tagging_directive", "COPY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_sourceBucketTaggingDirectiveTags(rName),
+				ExpectError: regexache.MustCompile(`tags cannot be configured when tagging_directive is COPY`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_sourceBucketTaggingDirectiveDefaultTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectConfig_sourceBucketTaggingDirectiveDefaultTags(rName, "REPLACE"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"Name":         rName,
+						"providerkey1": "providervalue1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+			{
+				// The source object's tags are merged with the provider's default tags, which take precedence.
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectConfig_sourceBucketTaggingDirectiveDefaultTags(rName, "COPY"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"Source":       "true",
+						"providerkey1": "providervalue1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_metadataDirective(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_metadataDirective(rName, "REPLACE", "value1", "STANDARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_directive", "REPLACE"),
+				),
+			},
+			{
+				// The object is copied in place with the new metadata.
+				Config: testAccObjectConfig_metadataDirective(rName, "REPLACE", "value2", "STANDARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value2"),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_metadataDirective(rName, "COPY", "value3", "STANDARD"),
+				ExpectError: regexache.MustCompile(`metadata_directive must be REPLACE to change`),
+			},
+			{
+				// Changing only the directive doesn't change the object.
+				Config: testAccObjectConfig_metadataDirective(rName, "COPY", "value2", "STANDARD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
+					resource.TestCheckResourceAttr(resourceName, "metadata_directive", "COPY"),
+				),
+			},
+			{
+				// The object is copied in place, keeping its metadata.
+				Config: testAccObjectConfig_metadataDirective(rName, "COPY", "value2", "STANDARD_IA"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "stuff"),
+					testAccCheckObjectStorageClass(ctx, resourceName, "STANDARD_IA"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_metadataDirectiveMultipartObject(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// Larger than the uploader's part size, so the object is uploaded in two parts.
+	data := strings.Repeat("0123456789abcdef", 6*1024*1024/16)
+	hash := sha256.Sum256([]byte(data))
+	source := testAccObjectCreateTempFile(t, data)
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_metadataDirectiveSource(rName, source, "text/plain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBodySHA256(&obj1, fmt.Sprintf("%x", hash)),
+					resource.TestCheckResourceAttr(resourceName, "content_length", strconv.Itoa(len(data))),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`-2$`)),
+				),
+			},
+			{
+				// Only the content type changes, so the object is copied in place without uploading its body again.
+				Config: testAccObjectConfig_metadataDirectiveSource(rName, source, "application/octet-stream"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectBodySHA256(&obj2, fmt.Sprintf("%x", hash)),
+					resource.TestCheckResourceAttr(resourceName, "content_length", strconv.Itoa(len(data))),
+					resource.TestCheckResourceAttr(resourceName, "content_sha256", fmt.Sprintf("%x", hash)),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_bodyUpdatesOnlyOnHashChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3, obj4, obj5 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_bodyUpdatesOnlyOnHashChange(rName, "initial", "v1", "text/plain", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "initial"),
+					resource.TestCheckResourceAttr(resourceName, "body_updates_only_on_hash_change", "true"),
+				),
+			},
+			{
+				// Tags are updated on the current object version.
+				Config: testAccObjectConfig_bodyUpdatesOnlyOnHashChange(rName, "initial", "v1", "text/plain", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value2"),
+				),
+			},
+			{
+				// The object is copied in place with the new metadata, without uploading its content.
+				Config: testAccObjectConfig_bodyUpdatesOnlyOnHashChange(rName, "initial", "v1", "text/html", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
+					testAccCheckObjectBody(&obj3, "initial"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/html"),
+				),
+			},
+			{
+				// Changing the content alone doesn't upload it.
+				Config: testAccObjectConfig_bodyUpdatesOnlyOnHashChange(rName, "changed", "v1", "text/html", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj4),
+					testAccCheckObjectVersionIDEquals(&obj4, &obj3),
+					testAccCheckObjectBody(&obj4, "initial"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_bodyUpdatesOnlyOnHashChange(rName, "changed", "v2", "text/html", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj5),
+					testAccCheckObjectVersionIDDiffers(&obj5, &obj4),
+					testAccCheckObjectBody(&obj5, "changed"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_replicationStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_replicationStatus(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "replication_status", regexache.MustCompile(`^(PENDING|COMPLETED)$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_deleteSpecificVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_deleteSpecificVersion(rName, "initial", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "delete_specific_version", "true"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_deleteSpecificVersion(rName, "changed", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+				),
+			},
+			{
+				// Only the tracked version is deleted, without adding a delete marker, so the previous version is current again.
+				Config: testAccObjectConfig_deleteSpecificVersionBucketOnly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectVersionCount(ctx, rName, "test-key", 1, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_deleteAllVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_deleteSpecificVersion(rName, "initial", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					resource.TestCheckResourceAttr(resourceName, "delete_specific_version", "false"),
+				),
+			},
+			{
+				Config: testAccObjectConfig_deleteSpecificVersion(rName, "changed", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectVersionIDDiffers(&obj2, &obj1),
+				),
+			},
+			{
+				// All of the object's versions are deleted.
+				Config: testAccObjectConfig_deleteSpecificVersionBucketOnly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectVersionCount(ctx, rName, "test-key", 0, 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_checksumMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The copied object has the source object's checksum, but no checksum_algorithm.
+				Config: testAccObjectConfig_checksumMode(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_mode", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_expiration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_expiration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "expiration.#", "1"),
+					acctest.CheckResourceAttrRFC3339(resourceName, "expiration.0.expiry_date"),
+					resource.TestCheckResourceAttr(resourceName, "expiration.0.rule_id", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_keyWithSlashesMigrated(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.S3ServiceID),
+		CheckDestroy: testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					// Final version for aws_s3_object using AWS SDK for Go v1.
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "5.16.0",
+					},
+				},
+				Config: testAccObjectConfig_keyWithSlashes(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "key", "/a/b//c///d/////e/"),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccObjectConfig_keyWithSlashes(rName),
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_directoryBucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_directoryBucket(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, ""),
+					resource.TestCheckNoResourceAttr(resourceName, "acl"),
+					acctest.MatchResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", regexache.MustCompile(fmt.Sprintf(`%s--[-a-z0-9]+--x-s3/%s$`, rName, "test-key"))),
+					resource.TestMatchResourceAttr(resourceName, "bucket", regexache.MustCompile(fmt.Sprintf(`^%s--[-a-z0-9]+--x-s3$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "content"),
+					resource.TestCheckNoResourceAttr(resourceName, "content_base64"),
+					resource.TestCheckResourceAttr(resourceName, "content_disposition", ""),
+					resource.TestCheckResourceAttr(resourceName, "content_encoding", ""),
+					resource.TestCheckResourceAttr(resourceName, "content_language", ""),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "expiration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key", "test-key"),
+					resource.TestCheckNoResourceAttr(resourceName, "kms_key_id"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
+					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "override_provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "override_provider.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "override_provider.0.default_tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "override_provider.0.default_tags.0.tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", "AES256"),
+					resource.TestCheckNoResourceAttr(resourceName, "source"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_hash"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "EXPRESS_ONEZONE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "website_redirect", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy", "override_provider"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not Found: %s", resourceName)
+					}
+
+					return fmt.Sprintf("s3://%s/test-key", rs.Primary.Attributes["bucket"]), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3Object_DirectoryBucket_disappears(t *testing.T) { // nosemgrep:ci.acceptance-test-naming-parent-disappears
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_directoryBucket(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceObject(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3Object_DirectoryBucket_append(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2, obj3 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_directoryBucketAppend(rName, "line 1\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "line 1\n"),
+					resource.TestCheckResourceAttr(resourceName, "append", "true"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "7"),
+				),
+			},
+			{
+				// Only "line 2\n" is written, at offset 7.
+				Config: testAccObjectConfig_directoryBucketAppend(rName, "line 1\nline 2\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectBody(&obj2, "line 1\nline 2\n"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "14"),
+				),
+			},
+			{
+				// The body doesn't start with the object's content, so the object is rewritten.
+				Config: testAccObjectConfig_directoryBucketAppend(rName, "line 3\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj3),
+					testAccCheckObjectBody(&obj3, "line 3\n"),
+					resource.TestCheckResourceAttr(resourceName, "content_length", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_appendGeneralPurposeBucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_appendGeneralPurposeBucket(rName),
+				ExpectError: regexache.MustCompile(`append is only supported for directory buckets`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_DirectoryBucket_DefaultTags_providerOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccObjectConfig_directoryBucket(rName),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_DirectoryBucket_contentType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj1, obj2 s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_directoryBucketContentType(rName, "text/plain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj1),
+					testAccCheckObjectBody(&obj1, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "text/plain"),
+				),
+			},
+			{
+				// The object is copied in place with the new content type.
+				Config: testAccObjectConfig_directoryBucketContentType(rName, "application/json"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj2),
+					testAccCheckObjectBody(&obj2, "stuff"),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_directive", "REPLACE"),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_directoryBucketWebsiteRedirect(rName),
+				ExpectError: regexache.MustCompile(`website_redirect \(/index.html\) is not supported for directory buckets`),
+			},
+		},
+	})
+}
+
+// https://github.com/hashicorp/terraform-provider-aws/issues/32385.
+func TestAccS3Object_prefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_prefix(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "content_type", "application/x-directory"),
+					resource.TestCheckResourceAttr(resourceName, "key", "pfx/"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64_sha256", "content_sha256", "force_destroy"},
+				ImportStateId:           fmt.Sprintf("s3://%s/pfx/", rName),
+			},
+		},
+	})
+}
+
+// S3 bucket is created in the alternate Region.
+func TestAccS3Object_crossRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_crossRegion(rName),
+				ExpectError: regexache.MustCompile(`PermanentRedirect`),
+			},
+		},
+	})
+}
+
+// https://github.com/hashicorp/terraform-provider-aws/issues/35325.
+func TestAccS3Object_optInRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	optInRegion := names.APEast1RegionID // Hong Kong.
+	providers := make(map[string]*schema.Provider)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegionNot(t, optInRegion)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckRegionOptIn(ctx, t, optInRegion)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectConfig_optInRegion(rName, optInRegion),
+				ExpectError: regexache.MustCompile(`IllegalLocationConstraintException`),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_tagsFromKeyPattern(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_tagsFromKeyPattern(rName, "tenants/acme/data.txt", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_from_key_pattern.#", "1"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"Key1":   "value1",
+						"Tenant": "acme",
+					}),
+				),
+			},
+			{
+				Config: testAccObjectConfig_tagsFromKeyPattern(rName, "tenants/acme/data.txt", "value1updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "value1updated"),
+					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
+						"Key1":   "value1updated",
+						"Tenant": "acme",
+					}),
+				),
+			},
+			{
+				Config:      testAccObjectConfig_tagsFromKeyPattern(rName, "data.txt", "value1updated"),
+				ExpectError: regexache.MustCompile(`does not match tags_from_key_pattern pattern`),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectVersionIDDiffers(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(first.VersionId) == aws.ToString(second.VersionId) {
+			return errors.New("S3 Object version IDs are equal")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectVersionIDEquals(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(first.VersionId) != aws.ToString(second.VersionId) {
+			return errors.New("S3 Object version IDs differ")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_object" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+			if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
+				conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+			}
+
+			var optFns []func(*s3.Options)
+			if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
+				optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+			}
+
+			_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(tfs3.ResolveObjectKey(rs.Primary.Attributes["key"], rs.Primary.Attributes["content_sha256"])), rs.Primary.Attributes["etag"], rs.Primary.Attributes["checksum_algorithm"], optFns...)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Object %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectExists(ctx context.Context, n string, v *s3.GetObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
+		var optFns []func(*s3.Options)
+		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
+			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+		}
+
+		input := &s3.GetObjectInput{
+			Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
+			Key:     aws.String(tfs3.SDKv1CompatibleCleanKey(tfs3.ResolveObjectKey(rs.Primary.Attributes["key"], rs.Primary.Attributes["content_sha256"]))),
+			IfMatch: aws.String(rs.Primary.Attributes["etag"]),
+		}
+
+		output, err := conn.GetObject(ctx, input, optFns...)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCheckObjectCreateMultipartUpload starts a multipart upload of the object outside of Terraform, without completing it.
+func testAccCheckObjectCreateMultipartUpload(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := &s3.CreateMultipartUploadInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"])),
+		}
+
+		_, err := conn.CreateMultipartUpload(ctx, input)
+
+		return err
+	}
+}
+
+// testAccCheckObjectNoMultipartUploads checks that the specified object in the bucket has no in-progress multipart uploads.
+func testAccCheckObjectNoMultipartUploads(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := &s3.ListMultipartUploadsInput{
+			Bucket: aws.String(rs.Primary.ID),
+			Prefix: aws.String(key),
+		}
+
+		output, err := conn.ListMultipartUploads(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Uploads {
+			if aws.ToString(v.Key) == key {
+				return fmt.Errorf("S3 Object (%s) multipart upload (%s) still in progress", key, aws.ToString(v.UploadId))
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckObjectPutContent replaces the object's content outside of Terraform, with a SHA-256 checksum.
+func testAccCheckObjectPutContent(ctx context.Context, n, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := &s3.PutObjectInput{
+			Body:              strings.NewReader(content),
+			Bucket:            aws.String(rs.Primary.Attributes["bucket"]),
+			ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+			Key:               aws.String(tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"])),
+		}
+
+		_, err := conn.PutObject(ctx, input)
+
+		return err
+	}
+}
+
+func testAccCheckObjectBody(obj *s3.GetObjectOutput, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		body, err := io.ReadAll(obj.Body)
+		if err != nil {
+			return err
+		}
+		obj.Body.Close()
+
+		if got := string(body); got != want {
+			return fmt.Errorf("S3 Object body = %v, want %v", got, want)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckObjectBodySHA256 checks the hex-encoded SHA-256 digest of the object's body, e.g. of bodies too large to compare.
+func testAccCheckObjectBodySHA256(obj *s3.GetObjectOutput, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		hash := sha256.New()
+		n, err := io.Copy(hash, obj.Body)
+		if err != nil {
+			return err
+		}
+		obj.Body.Close()
+
+		if got := fmt.Sprintf("%x", hash.Sum(nil)); got != want {
+			return fmt.Errorf("S3 Object body (%d bytes) SHA-256 digest = %v, want %v", n, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectACL(ctx context.Context, n string, want []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
+		var optFns []func(*s3.Options)
+		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
+			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+		}
+
+		input := &s3.GetObjectAclInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"])),
+		}
+
+		output, err := conn.GetObjectAcl(ctx, input, optFns...)
+
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		for _, v := range output.Grants {
+			got = append(got, string(v.Permission))
+		}
+		sort.Strings(got)
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			return fmt.Errorf("unexpected S3 Object ACL diff (+wanted, -got): %s", diff)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckObjectGrants checks the permissions granted to the object's owner by its canonical user ID.
+func testAccCheckObjectGrants(ctx context.Context, n string, want []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := &s3.GetObjectAclInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"])),
+		}
+
+		output, err := conn.GetObjectAcl(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		for _, v := range output.Grants {
+			if v.Grantee != nil && v.Grantee.Type == types.TypeCanonicalUser && aws.ToString(v.Grantee.ID) == aws.ToString(output.Owner.ID) {
+				got = append(got, string(v.Permission))
+			}
+		}
+		sort.Strings(got)
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			return fmt.Errorf("unexpected S3 Object grants diff (+wanted, -got): %s", diff)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectOwnedByBucketOwner(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		bucket := rs.Primary.Attributes["bucket"]
+
+		bucketACL, err := conn.GetBucketAcl(ctx, &s3.GetBucketAclInput{
+			Bucket: aws.String(bucket),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		objectACL, err := conn.GetObjectAcl(ctx, &s3.GetObjectAclInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"])),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(objectACL.Owner.ID), aws.ToString(bucketACL.Owner.ID); got != want {
+			return fmt.Errorf("S3 Object owner = %s, want bucket owner %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckObjectVersionCount checks the number of versions and delete markers of the object with the specified key.
+func testAccCheckObjectVersionCount(ctx context.Context, bucket, key string, wantVersions, wantDeleteMarkers int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		var versions, deleteMarkers int
+		pages := s3.NewListObjectVersionsPaginator(conn, &s3.ListObjectVersionsInput{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(key),
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.Versions {
+				if aws.ToString(v.Key) == key {
+					versions++
+				}
+			}
+			for _, v := range page.DeleteMarkers {
+				if aws.ToString(v.Key) == key {
+					deleteMarkers++
+				}
+			}
+		}
+
+		if versions != wantVersions || deleteMarkers != wantDeleteMarkers {
+			return fmt.Errorf("S3 Object (%s) has %d versions and %d delete markers, want %d and %d", key, versions, deleteMarkers, wantVersions, wantDeleteMarkers)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckObjectUnversionedOverwriteWarning checks the warning returned when the object is updated.
+// The testing framework doesn't expose warning diagnostics, so the check evaluates the warning against the object's bucket.
+func testAccCheckObjectUnversionedOverwriteWarning(ctx context.Context, n, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		diags := tfs3.ObjectUnversionedOverwriteWarning(ctx, conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"])
+
+		if want == "" {
+			if len(diags) > 0 {
+				return fmt.Errorf("S3 Object (%s) update warning = %q, want none", rs.Primary.ID, diags[0].Summary)
+			}
+
+			return nil
+		}
+
+		if len(diags) != 1 || diags[0].Severity != diag.Warning {
+			return fmt.Errorf("S3 Object (%s) update diagnostics = %v, want one warning", rs.Primary.ID, diags)
+		}
+
+		if got := diags[0].Summary; !strings.HasPrefix(got, want) {
+			return fmt.Errorf("S3 Object (%s) update warning = %q, want prefix %q", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectStorageClass(ctx context.Context, n, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
+		var optFns []func(*s3.Options)
+		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
+			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+		}
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), "", "", optFns...)
+
+		if err != nil {
+			return err
+		}
+
+		// The "STANDARD" (which is also the default) storage
+		// class when set would not be included in the results.
+		storageClass := types.StorageClassStandard
+		if output.StorageClass != "" {
+			storageClass = output.StorageClass
+		}
+
+		if got := string(storageClass); got != want {
+			return fmt.Errorf("S3 Object storage class = %v, want %v", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectSSE(ctx context.Context, n, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
+		var optFns []func(*s3.Options)
+		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
+			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+		}
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), "", "", optFns...)
+
+		if err != nil {
+			return err
+		}
+
+		if got := string(output.ServerSideEncryption); got != want {
+			return fmt.Errorf("S3 Object server-side encryption = %v, want %v", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccObjectCreateTempFile(t *testing.T, data string) string {
+	tmpFile, err := os.CreateTemp("", "tf-acc-s3-obj")
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := tmpFile.Name()
+
+	err = os.WriteFile(filename, []byte(data), 0644)
+	if err != nil {
+		os.Remove(filename)
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func testAccCheckObjectUpdateTags(ctx context.Context, n string, oldTags, newTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
+		var optFns []func(*s3.Options)
+		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
+			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+		}
+		if v := rs.Primary.Attributes["region"]; v != "" {
+			optFns = append(optFns, func(o *s3.Options) { o.Region = v })
+		}
+
+		return tfs3.ObjectUpdateTags(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), "", oldTags, newTags, optFns...)
+	}
+}
+
+func testAccCheckObjectCheckTags(ctx context.Context, n string, expectedTags map[string]string) resource.TestCheckFunc {
+	return testAccCheckObjectCheckVersionTags(ctx, n, nil, expectedTags)
+}
+
+// testAccCheckObjectCheckVersionTags checks the tags of the specified object version.
+// If obj is nil the tags of the current object version are checked.
+func testAccCheckObjectCheckVersionTags(ctx context.Context, n string, obj *s3.GetObjectOutput, expectedTags map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs := s.RootModule().Resources[n]
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
+			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
+		}
+
+		var optFns []func(*s3.Options)
+		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
+			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+		}
+		if v := rs.Primary.Attributes["region"]; v != "" {
+			optFns = append(optFns, func(o *s3.Options) { o.Region = v })
+		}
+
+		var versionID string
+		if obj != nil {
+			versionID = aws.ToString(obj.VersionId)
+		}
+
+		got, err := tfs3.ObjectListTags(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), versionID, optFns...)
+		if err != nil {
+			return err
+		}
+
+		want := tftags.New(ctx, expectedTags)
+		if diff := cmp.Diff(got, want); diff != "" {
+			return fmt.Errorf("unexpected S3 Object tags diff (+wanted, -got): %s", diff)
+		}
+
+		return nil
+	}
+}
+
+func testAccObjectConfig_baseAccessPoint(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_access_point" "test" {
+  # Must have bucket versioning enabled first
+  bucket = aws_s3_bucket_versioning.test.bucket
+  name   = %[1]q
+}
+`, rName)
+}
+
+func testAccObjectConfig_baseMultiRegionAccessPoint(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3control_multi_region_access_point" "test" {
+  details {
+    name = %[1]q
+
+    region {
+      bucket = aws_s3_bucket_versioning.test.bucket
+    }
+  }
+}
+`, rName)
+}
+
+func testAccObjectConfig_baseObjectLambdaAccessPoint(rName string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "index.handler"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_s3control_object_lambda_access_point" "test" {
+  name = %[1]q
+
+  configuration {
+    supporting_access_point = aws_s3_access_point.test.arn
+
+    transformation_configuration {
+      actions = ["GetObject"]
+
+      content_transformation {
+        aws_lambda {
+          function_arn = aws_lambda_function.test.arn
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccObjectConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+}
+`, rName)
+}
+
+func testAccObjectConfig_directoryPlaceholder(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "folder/"
+}
+`, rName)
+}
+
+func testAccObjectConfig_source(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "test-key"
+  source       = %[2]q
+  content_type = "binary/octet-stream"
+}
+`, rName, source)
+}
+
+func testAccObjectConfig_sourceStream(rName, source string, contentLength int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket         = aws_s3_bucket.test.bucket
+  key            = "test-key"
+  source         = %[2]q
+  content_length = %[3]d
+}
+`, rName, source, contentLength)
+}
+
+func testAccObjectConfig_contentCharacteristics(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket           = aws_s3_bucket.test.bucket
+  key              = "test-key"
+  source           = %[2]q
+  content_language = "en"
+  content_type     = "binary/octet-stream"
+  website_redirect = "http://google.com"
+}
+`, rName, source)
+}
+
+func testAccObjectConfig_content(rName string, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = %[2]q
+}
+`, rName, content)
+}
+
+func testAccObjectConfig_ifNoneMatchETagExists(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "stuff"
+}
+
+resource "aws_s3_object" "test" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = aws_s3_object.object.key
+  content            = "other stuff"
+  if_none_match_etag = "*"
+}
+`, rName)
+}
+
+func testAccObjectConfig_contentSecret(rName string, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket         = aws_s3_bucket.test.bucket
+  key            = "test-key"
+  content_secret = %[2]q
+}
+`, rName, content)
+}
+
+func testAccObjectConfig_sourceFromLocalFile(rName, contentBase64 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "local_file" "test" {
+  filename       = "${path.module}/%[1]s.bin"
+  content_base64 = %[2]q
+}
+
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket      = aws_s3_bucket_versioning.test.bucket
+  key         = "test-key"
+  source      = local_file.test.filename
+  source_hash = local_file.test.content_sha256
+}
+`, rName, contentBase64)
+}
+
+func testAccObjectConfig_timeouts(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = %[2]q
+
+  timeouts {
+    create = "90m"
+    read   = "5m"
+    update = "90m"
+    delete = "90m"
+  }
+}
+`, rName, content)
+}
+
+func testAccObjectConfig_region(rName, region string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  provider = "awsalternate"
+
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "Hello World"
+  region  = %[2]q
+
+  tags = {
+    Key1 = "Value1"
+  }
+}
+`, rName, region))
+}
+
+func testAccObjectConfig_regionTags1(rName, region, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  provider = "awsalternate"
+
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "Hello World"
+  region  = %[2]q
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, region, tagKey1, tagValue1))
+}
+
+func testAccObjectConfig_regionUnset(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  provider = "awsalternate"
+
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "Hello World"
+
+  tags = {
+    Key1 = "Value1"
+  }
+}
+`, rName))
+}
+
+func testAccObjectConfig_useAccelerateEndpoint(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_accelerate_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  status = %[2]q
+}
+
+resource "aws_s3_object" "object" {
+  # Must have Transfer Acceleration enabled first
+  bucket  = aws_s3_bucket_accelerate_configuration.test.bucket
+  key     = "test-key"
+  content = "Hello World"
+
+  use_accelerate_endpoint = true
+}
+`, rName, status)
+}
+
+func testAccObjectConfig_etagEncryption(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket                 = aws_s3_bucket.test.bucket
+  key                    = "test-key"
+  server_side_encryption = "AES256"
+  source                 = %[2]q
+  etag                   = filemd5(%[2]q)
+}
+`, rName, source)
+}
+
+func testAccObjectConfig_contentBase64(rName string, contentBase64 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket         = aws_s3_bucket.test.bucket
+  key            = "test-key"
+  content_base64 = %[2]q
+}
+`, rName, contentBase64)
+}
+
+func testAccObjectConfig_sourceHashTrigger(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket      = aws_s3_bucket.test.bucket
+  key         = "test-key"
+  source      = %[2]q
+  source_hash = filemd5(%[2]q)
+}
+`, rName, source)
+}
+
+func testAccObjectConfig_sourceHashTriggerSHA256(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket      = aws_s3_bucket.test.bucket
+  key         = "test-key"
+  source      = %[2]q
+  source_hash = filesha256(%[2]q)
+}
+`, rName, source)
+}
+
+func testAccObjectConfig_manageETagDisabled(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket      = aws_s3_bucket_versioning.test.bucket
+  key         = "test-key"
+  source      = %[2]q
+  source_hash = filemd5(%[2]q)
+  kms_key_id  = aws_kms_key.test.arn
+  manage_etag = false
+}
+`, rName, source)
+}
+
+func testAccObjectConfig_manageETagDisabledWithETag(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket      = aws_s3_bucket.test.bucket
+  key         = "test-key"
+  source      = %[2]q
+  etag        = filemd5(%[2]q)
+  manage_etag = false
+}
+`, rName, source)
+}
+
+func testAccObjectConfig_updateable(rName string, bucketVersioning bool, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket_3" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "object_bucket_3" {
+  bucket = aws_s3_bucket.object_bucket_3.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket = aws_s3_bucket_versioning.object_bucket_3.bucket
+  key    = "updateable-key"
+  source = %[3]q
+  etag   = filemd5(%[3]q)
+}
+`, rName, bucketVersioning, source)
+}
+
+func testAccObjectConfig_etagOverwrite(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = %[2]q
+  etag    = md5(%[2]q)
+}
+`, rName, content)
+}
+
+func testAccObjectConfig_etagOverwriteVersioned(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "test-key"
+  content = %[2]q
+  etag    = md5(%[2]q)
+}
+`, rName, content)
 }
 
-func TestAccS3Object_tagsMultipleSlashes(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_s3_object.object"
-	key := "first//second///third//"
+func testAccObjectConfig_maxVersions(rName, content string, maxVersions int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updatedTags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_noTags(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj3),
-					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
-					testAccCheckObjectBody(&obj3, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_tags(rName, key, "changed stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj4),
-					testAccCheckObjectVersionIDDiffers(&obj4, &obj3),
-					testAccCheckObjectBody(&obj4, "changed stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
-			},
-		},
-	})
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
 }
 
-func TestAccS3Object_tags_EmptyTag_OnCreate(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	key := "test-key"
-	resourceName := "aws_s3_object.object"
+resource "aws_s3_object" "object" {
+  bucket       = aws_s3_bucket_versioning.test.bucket
+  key          = "test-key"
+  content      = %[2]q
+  max_versions = %[3]d
+}
+`, rName, content, maxVersions)
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckBucketDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tags1(rName, key, "key1", ""),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", ""),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
-			},
-		},
-	})
+func testAccObjectConfig_updateableViaAccessPoint(rName string, source string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_access_point.test.arn
+  key    = "updateable-key"
+  source = %[1]q
+  etag   = filemd5(%[1]q)
+}
+`, source))
 }
 
-func TestAccS3Object_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	key := "test-key"
-	resourceName := "aws_s3_object.object"
+func testAccObjectConfig_updateableViaMultiRegionAccessPoint(rName string, source string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseMultiRegionAccessPoint(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket = aws_s3control_multi_region_access_point.test.arn
+  key    = "updateable-key"
+  source = %[1]q
+  etag   = filemd5(%[1]q)
+}
+`, source))
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckBucketDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tags1(rName, key, "key1", "value1"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_tags2(rName, key, "key1", "value1", "key2", ""),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", ""),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
-			},
-		},
-	})
+func testAccObjectConfig_kmsID(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "kms_key_1" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func TestAccS3Object_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	key := "test-key"
-	resourceName := "aws_s3_object.object"
+resource "aws_s3_object" "object" {
+  bucket     = aws_s3_bucket.test.bucket
+  key        = "test-key"
+  source     = %[2]q
+  kms_key_id = aws_kms_key.kms_key_1.arn
+}
+`, rName, source)
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckBucketDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tags1(rName, key, "key1", "value1"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_tags1(rName, key, "key1", ""),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", ""),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
-			},
-		},
-	})
+func testAccObjectConfig_kmsAlias(rName, aliasAttribute string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
 }
 
-func TestAccS3Object_DefaultTags_providerOnly(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+resource "aws_kms_alias" "test" {
+  name          = "alias/%[1]s"
+  target_key_id = aws_kms_key.test.key_id
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: acctest.ConfigCompose(
-					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
-					testAccObjectConfig_basic(rName),
-				),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
-		},
-	})
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket     = aws_s3_bucket_versioning.test.bucket
+  key        = "test-key"
+  content    = "stuff"
+  kms_key_id = aws_kms_alias.test.%[2]s
+}
+`, rName, aliasAttribute)
 }
 
-func TestAccS3Object_DefaultTags_providerAndResource(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	key := "test-key"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_sse(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: acctest.ConfigCompose(
-					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
-					testAccObjectConfig_tags(rName, key, "stuff"),
-				),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key3", "CCC"),
-				),
-			},
-			{
-				Config: acctest.ConfigCompose(
-					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
-					testAccObjectConfig_updatedTags(rName, key, "stuff"),
-				),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "5"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key5", "E:/"),
-				),
-			},
-		},
-	})
+resource "aws_s3_object" "object" {
+  bucket                 = aws_s3_bucket.test.bucket
+  key                    = "test-key"
+  source                 = %[2]q
+  server_side_encryption = "AES256"
+}
+`, rName, source)
 }
 
-func TestAccS3Object_DefaultTags_providerAndResourceWithOverride(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	key := "test-key"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_aclBucketOwnerEnforced(rName, acl string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: acctest.ConfigCompose(
-					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
-					testAccObjectConfig_tagsWithOverride(rName, key, "stuff"),
-				),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key3", "CCC"),
-				),
-			},
-			{
-				Config: acctest.ConfigCompose(
-					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
-					testAccObjectConfig_updatedTagsWithOverride(rName, key, "stuff"),
-				),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.Key5", "E:/"),
-				),
-			},
-		},
-	})
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.id
+  rule {
+    object_ownership = "BucketOwnerEnforced"
+  }
 }
 
-func TestAccS3Object_tagsViaAccessPointARN(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	key := "test-key"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+resource "aws_s3_object" "object" {
+  depends_on = [aws_s3_bucket_ownership_controls.test]
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tagsViaAccessPointARN(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updatedTagsViaAccessPointARN(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-				),
-			},
-		},
-	})
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key"
+  content = "stuff"
+  acl     = %[2]q
+}
+`, rName, acl)
 }
 
-func TestAccS3Object_tagsViaAccessPointAlias(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2 s3.GetObjectOutput
-	const resourceName = "aws_s3_object.object"
-	const key = "test-key"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_aclObjectOwnership(rName, objectOwnership, acl string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             acctest.CheckDestroyNoop, // Cannot access the object via the access point alias after the access point is destroyed
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tagsViaAccessPointAlias(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					acctest.MatchResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", regexache.MustCompile(fmt.Sprintf(`%s-\w+-s3alias/%s`, rName[:20], key))),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updatedTagsViaAccessPointAlias(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-				),
-			},
-		},
-	})
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.id
+  rule {
+    object_ownership = %[2]q
+  }
 }
 
-func TestAccS3Object_tagsViaMultiRegionAccessPoint(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	key := "test-key"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+resource "aws_s3_object" "object" {
+  depends_on = [aws_s3_bucket_ownership_controls.test]
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             acctest.CheckDestroyNoop, // Cannot access the object via the access point alias after the access point is destroyed
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tagsViaMultiRegionAccessPoint(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updatedTagsViaMultiRegionAccessPoint(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-				),
-			},
-		},
-	})
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key"
+  content = "stuff"
+  acl     = %[3]q
+}
+`, rName, objectOwnership, acl)
+}
+
+func testAccObjectConfig_grants(rName, grant string) string {
+	return fmt.Sprintf(`
+data "aws_canonical_user_id" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func TestAccS3Object_tagsViaObjectLambdaAccessPointARN(t *testing.T) {
-	t.Skip("Accessing Objects via Lambda Access Points is not yet supported")
-	ctx := acctest.Context(t)
-	var obj1, obj2 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	key := "test-key"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_tagsViaObjectLambdaAccessPointARN(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-				),
-			},
-			{
-				Config: testAccObjectConfig_updatedTagsViaObjectLambdaAccessPointARN(rName, key, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "4"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "B@BB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "X X"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key4", "DDD"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key5", "E:/"),
-				),
-			},
-		},
-	})
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
 }
 
-func TestAccS3Object_objectLockLegalHoldStartWithNone(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2, obj3 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.id
+  rule {
+    object_ownership = "BucketOwnerPreferred"
+  }
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_noLockLegalHold(rName, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-			{
-				Config: testAccObjectConfig_lockLegalHold(rName, "stuff", "ON"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-			// Remove legal hold but create a new object version to test force_destroy
-			{
-				Config: testAccObjectConfig_lockLegalHold(rName, "changed stuff", "OFF"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj3),
-					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
-					testAccCheckObjectBody(&obj3, "changed stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "OFF"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-		},
-	})
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
 }
 
-func TestAccS3Object_objectLockLegalHoldStartWithOn(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+resource "aws_s3_object" "object" {
+  depends_on = [
+    aws_s3_bucket_public_access_block.test,
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_versioning.test,
+  ]
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_lockLegalHold(rName, "stuff", "ON"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "ON"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-			{
-				Config: testAccObjectConfig_lockLegalHold(rName, "stuff", "OFF"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", "OFF"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-		},
-	})
-}
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key"
+  content = "some_bucket_content"
 
-func TestAccS3Object_objectLockRetentionStartWithNone(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2, obj3 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	retainUntilDate := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
+  grant_full_control = ["id=${data.aws_canonical_user_id.current.id}"]
+  %[2]s              = ["id=${data.aws_canonical_user_id.current.id}"]
+}
+`, rName, grant)
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_noLockRetention(rName, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-			{
-				Config: testAccObjectConfig_lockRetention(rName, "stuff", retainUntilDate),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate),
-				),
-			},
-			// Remove retention period but create a new object version to test force_destroy
-			{
-				Config: testAccObjectConfig_noLockRetention(rName, "changed stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj3),
-					testAccCheckObjectVersionIDDiffers(&obj3, &obj2),
-					testAccCheckObjectBody(&obj3, "changed stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-		},
-	})
+func testAccObjectConfig_acl(rName, content, acl string, blockPublicAccess bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func TestAccS3Object_objectLockRetentionStartWithSet(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1, obj2, obj3, obj4 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	retainUntilDate1 := time.Now().UTC().AddDate(0, 0, 20).Format(time.RFC3339)
-	retainUntilDate2 := time.Now().UTC().AddDate(0, 0, 30).Format(time.RFC3339)
-	retainUntilDate3 := time.Now().UTC().AddDate(0, 0, 10).Format(time.RFC3339)
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_lockRetention(rName, "stuff", retainUntilDate1),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate1),
-				),
-			},
-			{
-				Config: testAccObjectConfig_lockRetention(rName, "stuff", retainUntilDate2),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj2),
-					testAccCheckObjectVersionIDEquals(&obj2, &obj1),
-					testAccCheckObjectBody(&obj2, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate2),
-				),
-			},
-			{
-				Config: testAccObjectConfig_lockRetention(rName, "stuff", retainUntilDate3),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj3),
-					testAccCheckObjectVersionIDEquals(&obj3, &obj2),
-					testAccCheckObjectBody(&obj3, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", "GOVERNANCE"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", retainUntilDate3),
-				),
-			},
-			{
-				Config: testAccObjectConfig_noLockRetention(rName, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj4),
-					testAccCheckObjectVersionIDEquals(&obj4, &obj3),
-					testAccCheckObjectBody(&obj4, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-				),
-			},
-		},
-	})
+  block_public_acls       = %[4]t
+  block_public_policy     = %[4]t
+  ignore_public_acls      = %[4]t
+  restrict_public_buckets = %[4]t
 }
 
-func TestAccS3Object_objectBucketKeyEnabled(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.id
+  rule {
+    object_ownership = "BucketOwnerPreferred"
+  }
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_bucketKeyEnabled(rName, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "true"),
-				),
-			},
-		},
-	})
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
 }
 
-func TestAccS3Object_bucketBucketKeyEnabled(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+resource "aws_s3_object" "object" {
+  depends_on = [
+    aws_s3_bucket_public_access_block.test,
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_versioning.test,
+  ]
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_bucketBucketKeyEnabled(rName, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "true"),
-				),
-			},
-		},
-	})
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key"
+  content = %[2]q
+  acl     = %[3]q
+}
+`, rName, content, acl, blockPublicAccess)
 }
 
-func TestAccS3Object_defaultBucketSSE(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj1 s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_baseAccessControlPolicy(rName string) string {
+	return fmt.Sprintf(`
+data "aws_canonical_user_id" "current" {}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_defaultBucketSSE(rName, "stuff"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj1),
-					testAccCheckObjectBody(&obj1, "stuff"),
-				),
-			},
-		},
-	})
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func TestAccS3Object_ignoreTags(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_s3_object.object"
-	key := "test-key"
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.id
+  rule {
+    object_ownership = "BucketOwnerPreferred"
+  }
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: acctest.ConfigCompose(
-					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
-					testAccObjectConfig_noTags(rName, key, "stuff")),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "stuff"),
-					testAccCheckObjectUpdateTags(ctx, resourceName, nil, map[string]string{"ignorekey1": "ignorevalue1"}),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
-						"ignorekey1": "ignorevalue1",
-					}),
-				),
-			},
-			{
-				Config: acctest.ConfigCompose(
-					acctest.ConfigIgnoreTagsKeyPrefixes1("ignorekey"),
-					testAccObjectConfig_tags(rName, key, "stuff")),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "stuff"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "3"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "A@AA"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "BBB"),
-					resource.TestCheckResourceAttr(resourceName, "tags.Key3", "CCC"),
-					testAccCheckObjectCheckTags(ctx, resourceName, map[string]string{
-						"ignorekey1": "ignorevalue1",
-						"Key1":       "A@AA",
-						"Key2":       "BBB",
-						"Key3":       "CCC",
-					}),
-				),
-			},
-		},
-	})
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+`, rName)
 }
 
-func TestAccS3Object_checksumAlgorithm(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_accessControlPolicy(rName, content, permission string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseAccessControlPolicy(rName), fmt.Sprintf(`
+resource "aws_s3_object" "object" {
+  depends_on = [
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_versioning.test,
+  ]
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_checksumAlgorithm(rName, "CRC32"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
-					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32"),
-					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", "q/d4Ig=="),
-					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"checksum_algorithm", "checksum_crc32", "content", "force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
-			},
-			{
-				Config: testAccObjectConfig_checksumAlgorithm(rName, "SHA256"),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
-					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
-					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
-				),
-			},
-		},
-	})
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key"
+  content = %[1]q
+
+  access_control_policy {
+    grant {
+      grantee {
+        id   = data.aws_canonical_user_id.current.id
+        type = "CanonicalUser"
+      }
+      permission = "FULL_CONTROL"
+    }
+
+    grant {
+      grantee {
+        id   = data.aws_canonical_user_id.current.id
+        type = "CanonicalUser"
+      }
+      permission = %[2]q
+    }
+
+    grant {
+      grantee {
+        type = "Group"
+        uri  = "http://acs.amazonaws.com/groups/s3/LogDelivery"
+      }
+      permission = "WRITE_ACP"
+    }
+
+    owner {
+      id = data.aws_canonical_user_id.current.id
+    }
+  }
+}
+`, content, permission))
 }
 
-func TestAccS3Object_keyWithSlashesMigrated(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_accessControlPolicyLogDeliveryGroup(rName string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseAccessControlPolicy(rName), `
+resource "aws_s3_object" "object" {
+  depends_on = [
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_versioning.test,
+  ]
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:   acctest.ErrorCheck(t, names.S3ServiceID),
-		CheckDestroy: testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				ExternalProviders: map[string]resource.ExternalProvider{
-					// Final version for aws_s3_object using AWS SDK for Go v1.
-					"aws": {
-						Source:            "hashicorp/aws",
-						VersionConstraint: "5.16.0",
-					},
-				},
-				Config: testAccObjectConfig_keyWithSlashes(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
-					resource.TestCheckResourceAttr(resourceName, "key", "/a/b//c///d/////e/"),
-				),
-			},
-			{
-				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-				Config:                   testAccObjectConfig_keyWithSlashes(rName),
-				PlanOnly:                 true,
-			},
-		},
-	})
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key"
+  content = "some_bucket_content"
+
+  access_control_policy {
+    grant {
+      grantee {
+        id   = data.aws_canonical_user_id.current.id
+        type = "CanonicalUser"
+      }
+      permission = "FULL_CONTROL"
+    }
+
+    grant {
+      grantee {
+        type = "Group"
+        uri  = "http://acs.amazonaws.com/groups/s3/LogDelivery"
+      }
+      permission = "READ_ACP"
+    }
+
+    grant {
+      grantee {
+        type = "Group"
+        uri  = "http://acs.amazonaws.com/groups/s3/LogDelivery"
+      }
+      permission = "WRITE_ACP"
+    }
+
+    owner {
+      id = data.aws_canonical_user_id.current.id
+    }
+  }
+}
+`)
 }
 
-func TestAccS3Object_directoryBucket(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_accessControlPolicyAndACL(rName string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseAccessControlPolicy(rName), `
+resource "aws_s3_object" "object" {
+  depends_on = [
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_versioning.test,
+  ]
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_directoryBucket(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					testAccCheckObjectBody(&obj, ""),
-					resource.TestCheckNoResourceAttr(resourceName, "acl"),
-					acctest.MatchResourceAttrGlobalARNNoAccount(resourceName, "arn", "s3", regexache.MustCompile(fmt.Sprintf(`%s--[-a-z0-9]+--x-s3/%s$`, rName, "test-key"))),
-					resource.TestMatchResourceAttr(resourceName, "bucket", regexache.MustCompile(fmt.Sprintf(`^%s--[-a-z0-9]+--x-s3$`, rName))),
-					resource.TestCheckResourceAttr(resourceName, "bucket_key_enabled", "false"),
-					resource.TestCheckResourceAttr(resourceName, "cache_control", ""),
-					resource.TestCheckNoResourceAttr(resourceName, "checksum_algorithm"),
-					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
-					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
-					resource.TestCheckNoResourceAttr(resourceName, "content"),
-					resource.TestCheckNoResourceAttr(resourceName, "content_base64"),
-					resource.TestCheckResourceAttr(resourceName, "content_disposition", ""),
-					resource.TestCheckResourceAttr(resourceName, "content_encoding", ""),
-					resource.TestCheckResourceAttr(resourceName, "content_language", ""),
-					resource.TestCheckResourceAttr(resourceName, "content_type", "application/octet-stream"),
-					resource.TestCheckResourceAttrSet(resourceName, "etag"),
-					resource.TestCheckResourceAttr(resourceName, "force_destroy", "false"),
-					resource.TestCheckResourceAttr(resourceName, "key", "test-key"),
-					resource.TestCheckNoResourceAttr(resourceName, "kms_key_id"),
-					resource.TestCheckResourceAttr(resourceName, "metadata.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_legal_hold_status", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_mode", ""),
-					resource.TestCheckResourceAttr(resourceName, "object_lock_retain_until_date", ""),
-					resource.TestCheckResourceAttr(resourceName, "override_provider.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "override_provider.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "override_provider.0.default_tags.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "override_provider.0.default_tags.0.tags.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", "AES256"),
-					resource.TestCheckNoResourceAttr(resourceName, "source"),
-					resource.TestCheckNoResourceAttr(resourceName, "source_hash"),
-					resource.TestCheckResourceAttr(resourceName, "storage_class", "EXPRESS_ONEZONE"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "version_id", ""),
-					resource.TestCheckResourceAttr(resourceName, "website_redirect", ""),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy", "override_provider"},
-				ImportStateIdFunc: func(s *terraform.State) (string, error) {
-					rs, ok := s.RootModule().Resources[resourceName]
-					if !ok {
-						return "", fmt.Errorf("Not Found: %s", resourceName)
-					}
+  bucket  = aws_s3_bucket.test.id
+  key     = "test-key"
+  content = "some_bucket_content"
+  acl     = "private"
+
+  access_control_policy {
+    grant {
+      grantee {
+        id   = data.aws_canonical_user_id.current.id
+        type = "CanonicalUser"
+      }
+      permission = "FULL_CONTROL"
+    }
 
-					return fmt.Sprintf("s3://%s/test-key", rs.Primary.Attributes["bucket"]), nil
-				},
-			},
-		},
-	})
+    owner {
+      id = data.aws_canonical_user_id.current.id
+    }
+  }
+}
+`)
 }
 
-func TestAccS3Object_DirectoryBucket_disappears(t *testing.T) { // nosemgrep:ci.acceptance-test-naming-parent-disappears
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_storageClass(rName string, storage_class string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_directoryBucket(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceObject(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
-		},
-	})
+resource "aws_s3_object" "object" {
+  bucket        = aws_s3_bucket.test.bucket
+  key           = "test-key"
+  content       = "some_bucket_content"
+  storage_class = %[2]q
+}
+`, rName, storage_class)
 }
 
-func TestAccS3Object_DirectoryBucket_DefaultTags_providerOnly(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_tags(rName, key, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: acctest.ConfigCompose(
-					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
-					testAccObjectConfig_directoryBucket(rName),
-				),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
-				),
-			},
-		},
-	})
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
 }
 
-// https://github.com/hashicorp/terraform-provider-aws/issues/32385.
-func TestAccS3Object_prefix(t *testing.T) {
-	ctx := acctest.Context(t)
-	var obj s3.GetObjectOutput
-	resourceName := "aws_s3_object.object"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = %[2]q
+  content = %[3]q
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccObjectConfig_prefix(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckObjectExists(ctx, resourceName, &obj),
-					resource.TestCheckResourceAttr(resourceName, "content_type", "application/x-directory"),
-					resource.TestCheckResourceAttr(resourceName, "key", "pfx/"),
-				),
-			},
-			{
-				ResourceName:            resourceName,
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
-				ImportStateId:           fmt.Sprintf("s3://%s/pfx/", rName),
-			},
-		},
-	})
+  tags = {
+    Key1 = "A@AA"
+    Key2 = "BBB"
+    Key3 = "CCC"
+  }
+}
+`, rName, key, content)
 }
 
-// S3 bucket is created in the alternate Region.
-func TestAccS3Object_crossRegion(t *testing.T) {
-	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func testAccObjectConfig_refreshMode(rName, refreshMode string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
-			acctest.PreCheck(ctx, t)
-			acctest.PreCheckMultipleRegion(t, 2)
-		},
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config:      testAccObjectConfig_crossRegion(rName),
-				ExpectError: regexache.MustCompile(`PermanentRedirect`),
-			},
-		},
-	})
+resource "aws_s3_object" "object" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "test-key"
+  content      = "stuff"
+  refresh_mode = %[2]q
+
+  tags = {
+    Key1 = "Value1"
+  }
+}
+`, rName, refreshMode)
 }
 
-// https://github.com/hashicorp/terraform-provider-aws/issues/35325.
-func TestAccS3Object_optInRegion(t *testing.T) {
-	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	optInRegion := names.APEast1RegionID // Hong Kong.
-	providers := make(map[string]*schema.Provider)
+func testAccObjectConfig_updatedTags(rName, key, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
-			acctest.PreCheck(ctx, t)
-			acctest.PreCheckRegionNot(t, optInRegion)
-			acctest.PreCheckOrganizationManagementAccount(ctx, t)
-			acctest.PreCheckRegionOptIn(ctx, t, optInRegion)
-		},
-		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
-		CheckDestroy:             testAccCheckObjectDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config:      testAccObjectConfig_optInRegion(rName, optInRegion),
-				ExpectError: regexache.MustCompile(`IllegalLocationConstraintException`),
-			},
-		},
-	})
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
 }
 
-func testAccCheckObjectVersionIDDiffers(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		if aws.ToString(first.VersionId) == aws.ToString(second.VersionId) {
-			return errors.New("S3 Object version IDs are equal")
-		}
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = %[2]q
+  content = %[3]q
 
-		return nil
-	}
+  tags = {
+    Key2 = "B@BB"
+    Key3 = "X X"
+    Key4 = "DDD"
+    Key5 = "E:/"
+  }
+}
+`, rName, key, content)
 }
 
-func testAccCheckObjectVersionIDEquals(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		if aws.ToString(first.VersionId) != aws.ToString(second.VersionId) {
-			return errors.New("S3 Object version IDs differ")
-		}
-
-		return nil
-	}
+func testAccObjectConfig_noTags(rName, key, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func testAccCheckObjectDestroy(ctx context.Context) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "aws_s3_object" {
-				continue
-			}
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
 
-			conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
-			if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
-				conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
-			}
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = %[2]q
+  content = %[3]q
+}
+`, rName, key, content)
+}
 
-			var optFns []func(*s3.Options)
-			if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
-				optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
-			}
+func testAccObjectConfig_tagsWithOverride(rName, key, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-			_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), rs.Primary.Attributes["etag"], rs.Primary.Attributes["checksum_algorithm"], optFns...)
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
 
-			if tfresource.NotFound(err) {
-				continue
-			}
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = %[2]q
+  content = %[3]q
 
-			if err != nil {
-				return err
-			}
+  tags = {
+    Key1 = "A@AA"
+    Key2 = "BBB"
+    Key3 = "CCC"
+  }
 
-			return fmt.Errorf("S3 Object %s still exists", rs.Primary.ID)
-		}
+  override_provider {
+    default_tags {
+      tags = {}
+    }
+  }
+}
+`, rName, key, content)
+}
 
-		return nil
-	}
+func testAccObjectConfig_updatedTagsWithOverride(rName, key, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func testAccCheckObjectExists(ctx context.Context, n string, v *s3.GetObjectOutput) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not Found: %s", n)
-		}
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
-		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
-			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
-		}
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = %[2]q
+  content = %[3]q
 
-		var optFns []func(*s3.Options)
-		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
-			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
-		}
+  tags = {
+    Key2 = "B@BB"
+    Key3 = "X X"
+    Key4 = "DDD"
+    Key5 = "E:/"
+  }
 
-		input := &s3.GetObjectInput{
-			Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
-			Key:     aws.String(tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"])),
-			IfMatch: aws.String(rs.Primary.Attributes["etag"]),
-		}
+  override_provider {
+    default_tags {
+      tags = {}
+    }
+  }
+}
+`, rName, key, content)
+}
 
-		output, err := conn.GetObject(ctx, input, optFns...)
+func testAccObjectConfig_tags1(rName, key, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-		if err != nil {
-			return err
-		}
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
 
-		*v = *output
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket = aws_s3_bucket_versioning.test.bucket
+  key    = %[2]q
 
-		return nil
-	}
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, key, tagKey1, tagValue1)
 }
 
-func testAccCheckObjectBody(obj *s3.GetObjectOutput, want string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		body, err := io.ReadAll(obj.Body)
-		if err != nil {
-			return err
-		}
-		obj.Body.Close()
-
-		if got := string(body); got != want {
-			return fmt.Errorf("S3 Object body = %v, want %v", got, want)
-		}
+func testAccObjectConfig_tags2(rName, key, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-		return nil
-	}
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
 }
 
-func testAccCheckObjectACL(ctx context.Context, n string, want []string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs := s.RootModule().Resources[n]
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket = aws_s3_bucket_versioning.test.bucket
+  key    = %[2]q
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
-		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
-			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
-		}
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, key, tagKey1, tagValue1, tagKey2, tagValue2)
+}
 
-		var optFns []func(*s3.Options)
-		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
-			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
-		}
+func testAccObjectConfig_contentTags2(rName, key, content, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-		input := &s3.GetObjectAclInput{
-			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
-			Key:    aws.String(tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"])),
-		}
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = %[2]q
+  content = %[3]q
 
-		output, err := conn.GetObjectAcl(ctx, input, optFns...)
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, key, content, tagKey1, tagValue1, tagKey2, tagValue2)
+}
 
-		if err != nil {
-			return err
-		}
+func testAccObjectConfig_mergeExistingTags1(rName, content, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-		var got []string
-		for _, v := range output.Grants {
-			got = append(got, string(v.Permission))
-		}
-		sort.Strings(got)
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = %[2]q
 
-		if diff := cmp.Diff(got, want); diff != "" {
-			return fmt.Errorf("unexpected S3 Object ACL diff (+wanted, -got): %s", diff)
-		}
+  merge_existing_tags = true
 
-		return nil
-	}
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, content, tagKey1, tagValue1)
 }
 
-func testAccCheckObjectStorageClass(ctx context.Context, n, want string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs := s.RootModule().Resources[n]
+func testAccObjectConfig_mergeExistingTags2(rName, content, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
-		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
-			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
-		}
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = %[2]q
 
-		var optFns []func(*s3.Options)
-		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
-			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
-		}
+  merge_existing_tags = true
 
-		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), "", "", optFns...)
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, content, tagKey1, tagValue1, tagKey2, tagValue2)
+}
 
-		if err != nil {
-			return err
-		}
+func testAccObjectConfig_tagsViaAccessPointARN(rName, key, content string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_access_point.test.arn
+  key     = %[1]q
+  content = %[2]q
 
-		// The "STANDARD" (which is also the default) storage
-		// class when set would not be included in the results.
-		storageClass := types.StorageClassStandard
-		if output.StorageClass != "" {
-			storageClass = output.StorageClass
-		}
+  tags = {
+    Key1 = "A@AA"
+    Key2 = "BBB"
+    Key3 = "CCC"
+  }
+}
+`, key, content))
+}
 
-		if got := string(storageClass); got != want {
-			return fmt.Errorf("S3 Object storage class = %v, want %v", got, want)
-		}
+func testAccObjectConfig_updatedTagsViaAccessPointARN(rName, key, content string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_access_point.test.arn
+  key     = %[1]q
+  content = %[2]q
 
-		return nil
-	}
+  tags = {
+    Key2 = "B@BB"
+    Key3 = "X X"
+    Key4 = "DDD"
+    Key5 = "E:/"
+  }
+}
+`, key, content))
 }
 
-func testAccCheckObjectSSE(ctx context.Context, n, want string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs := s.RootModule().Resources[n]
-
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
-		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
-			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
-		}
+func testAccObjectConfig_tagsViaAccessPointAlias(rName, key, content string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_access_point.test.alias
+  key     = %[1]q
+  content = %[2]q
 
-		var optFns []func(*s3.Options)
-		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
-			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
-		}
+  tags = {
+    Key1 = "A@AA"
+    Key2 = "BBB"
+    Key3 = "CCC"
+  }
+}
+`, key, content))
+}
 
-		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), "", "", optFns...)
+func testAccObjectConfig_updatedTagsViaAccessPointAlias(rName, key, content string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_access_point.test.alias
+  key     = %[1]q
+  content = %[2]q
 
-		if err != nil {
-			return err
-		}
+  tags = {
+    Key2 = "B@BB"
+    Key3 = "X X"
+    Key4 = "DDD"
+    Key5 = "E:/"
+  }
+}
+`, key, content))
+}
 
-		if got := string(output.ServerSideEncryption); got != want {
-			return fmt.Errorf("S3 Object server-side encryption = %v, want %v", got, want)
-		}
+func testAccObjectConfig_tagsViaMultiRegionAccessPoint(rName, key, content string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseMultiRegionAccessPoint(rName), fmt.Sprintf(`
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3control_multi_region_access_point.test.arn
+  key     = %[1]q
+  content = %[2]q
 
-		return nil
-	}
+  tags = {
+    Key1 = "A@AA"
+    Key2 = "BBB"
+    Key3 = "CCC"
+  }
+}
+`, key, content))
 }
 
-func testAccObjectCreateTempFile(t *testing.T, data string) string {
-	tmpFile, err := os.CreateTemp("", "tf-acc-s3-obj")
-	if err != nil {
-		t.Fatal(err)
-	}
-	filename := tmpFile.Name()
-
-	err = os.WriteFile(filename, []byte(data), 0644)
-	if err != nil {
-		os.Remove(filename)
-		t.Fatal(err)
-	}
+func testAccObjectConfig_updatedTagsViaMultiRegionAccessPoint(rName, key, content string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseMultiRegionAccessPoint(rName), fmt.Sprintf(`
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3control_multi_region_access_point.test.arn
+  key     = %[1]q
+  content = %[2]q
 
-	return filename
+  tags = {
+    Key2 = "B@BB"
+    Key3 = "X X"
+    Key4 = "DDD"
+    Key5 = "E:/"
+  }
+}
+`, key, content))
 }
 
-func testAccCheckObjectUpdateTags(ctx context.Context, n string, oldTags, newTags map[string]string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs := s.RootModule().Resources[n]
+func testAccObjectConfig_tagsViaObjectLambdaAccessPointARN(rName, key, content string) string {
+	return acctest.ConfigCompose(testAccObjectConfig_baseObjectLambdaAccessPoint(rName), fmt.Sprintf(`
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3control_object_lambda_access_point.test.arn
+  key     = %[1]q
+  content = %[2]q
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
-		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
-			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
-		}
+  tags = {
+    Key1 = "A@AA"
+    Key2 = "BBB"
+    Key3 = "CCC"
+  }
+}
+`, key, content))
+}
 
-		var optFns []func(*s3.Options)
-		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
-			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
-		}
+func testAccObjectConfig_metadata(rName string, metadataKey1, metadataValue1, metadataKey2, metadataValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-		return tfs3.ObjectUpdateTags(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), oldTags, newTags, optFns...)
-	}
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+
+  metadata = {
+    %[2]s = %[3]q
+    %[4]s = %[5]q
+  }
+}
+`, rName, metadataKey1, metadataValue1, metadataKey2, metadataValue2)
 }
 
-func testAccCheckObjectCheckTags(ctx context.Context, n string, expectedTags map[string]string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs := s.RootModule().Resources[n]
+func testAccObjectConfig_expires(rName, expires string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
-		if tfs3.IsDirectoryBucket(rs.Primary.Attributes["bucket"]) {
-			conn = acctest.Provider.Meta().(*conns.AWSClient).S3ExpressClient(ctx)
-		}
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "some_bucket_content"
+  expires = %[2]q
+}
+`, rName, expires)
+}
 
-		var optFns []func(*s3.Options)
-		if arn.IsARN(rs.Primary.Attributes["bucket"]) && conn.Options().Region == names.GlobalRegionID {
-			optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
-		}
+func testAccObjectConfig_noLockLegalHold(rName string, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 
-		got, err := tfs3.ObjectListTags(ctx, conn, rs.Primary.Attributes["bucket"], tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"]), optFns...)
-		if err != nil {
-			return err
-		}
+  object_lock_enabled = true
+}
 
-		want := tftags.New(ctx, expectedTags)
-		if diff := cmp.Diff(got, want); diff != "" {
-			return fmt.Errorf("unexpected S3 Object tags diff (+wanted, -got): %s", diff)
-		}
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
 
-		return nil
-	}
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket        = aws_s3_bucket_versioning.test.bucket
+  key           = "test-key"
+  content       = %[2]q
+  force_destroy = true
+}
+`, rName, content)
 }
 
-func testAccObjectConfig_baseAccessPoint(rName string) string {
+func testAccObjectConfig_lockLegalHold(rName string, content, legalHoldStatus string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
+
+  object_lock_enabled = true
 }
 
 resource "aws_s3_bucket_versioning" "test" {
@@ -2464,18 +8876,38 @@ resource "aws_s3_bucket_versioning" "test" {
   }
 }
 
-resource "aws_s3_access_point" "test" {
+resource "aws_s3_object" "object" {
   # Must have bucket versioning enabled first
-  bucket = aws_s3_bucket_versioning.test.bucket
-  name   = %[1]q
+  bucket                        = aws_s3_bucket_versioning.test.bucket
+  key                           = "test-key"
+  content                       = %[2]q
+  object_lock_legal_hold_status = %[3]q
+  force_destroy                 = true
+}
+`, rName, content, legalHoldStatus)
+}
+
+func testAccObjectConfig_forceDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket        = aws_s3_bucket.test.bucket
+  key           = "test-key"
+  content       = "stuff"
+  force_destroy = true
 }
 `, rName)
 }
 
-func testAccObjectConfig_baseMultiRegionAccessPoint(rName string) string {
+func testAccObjectConfig_forceDestroyBypassLegalHold(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
+
+  object_lock_enabled = true
 }
 
 resource "aws_s3_bucket_versioning" "test" {
@@ -2485,95 +8917,163 @@ resource "aws_s3_bucket_versioning" "test" {
   }
 }
 
-resource "aws_s3control_multi_region_access_point" "test" {
-  details {
-    name = %[1]q
-
-    region {
-      bucket = aws_s3_bucket_versioning.test.bucket
-    }
-  }
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket                          = aws_s3_bucket_versioning.test.bucket
+  key                             = "test-key"
+  content                         = "stuff"
+  object_lock_legal_hold_status   = "ON"
+  force_destroy_bypass_legal_hold = true
 }
 `, rName)
 }
 
-func testAccObjectConfig_baseObjectLambdaAccessPoint(rName string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
-resource "aws_lambda_function" "test" {
-  filename      = "test-fixtures/lambdatest.zip"
-  function_name = %[1]q
-  role          = aws_iam_role.iam_for_lambda.arn
-  handler       = "index.handler"
-  runtime       = "nodejs20.x"
+func testAccObjectConfig_noLockRetention(rName string, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  object_lock_enabled = true
 }
 
-resource "aws_s3control_object_lambda_access_point" "test" {
-  name = %[1]q
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
 
-  configuration {
-    supporting_access_point = aws_s3_access_point.test.arn
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket        = aws_s3_bucket_versioning.test.bucket
+  key           = "test-key"
+  content       = %[2]q
+  force_destroy = true
+}
+`, rName, content)
+}
 
-    transformation_configuration {
-      actions = ["GetObject"]
+func testAccObjectConfig_lockRetention(rName string, content, retainUntilDate string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 
-      content_transformation {
-        aws_lambda {
-          function_arn = aws_lambda_function.test.arn
-        }
-      }
-    }
+  object_lock_enabled = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
   }
 }
-`, rName))
+
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket                        = aws_s3_bucket_versioning.test.bucket
+  key                           = "test-key"
+  content                       = %[2]q
+  force_destroy                 = true
+  object_lock_mode              = "GOVERNANCE"
+  object_lock_retain_until_date = %[3]q
+}
+`, rName, content, retainUntilDate)
 }
 
-func testAccObjectConfig_basic(rName string) string {
+func testAccObjectConfig_lockLegalHoldAndRetention(rName, mode, retainUntilDate string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
-  bucket        = %[1]q
-  force_destroy = true
+  bucket = %[1]q
+
+  object_lock_enabled = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
 }
 
 resource "aws_s3_object" "object" {
-  bucket = aws_s3_bucket.test.bucket
-  key    = "test-key"
+  # Must have bucket versioning enabled first
+  bucket                                    = aws_s3_bucket_versioning.test.bucket
+  key                                       = "test-key"
+  content                                   = "stuff"
+  force_destroy_bypass_governance_retention = true
+  force_destroy_bypass_legal_hold           = true
+  object_lock_legal_hold_status             = "ON"
+  object_lock_mode                          = %[2]q
+  object_lock_retain_until_date             = %[3]q != "" ? %[3]q : null
 }
-`, rName)
+`, rName, mode, retainUntilDate)
 }
 
-func testAccObjectConfig_source(rName string, source string) string {
+func testAccObjectConfig_lockRetentionBucketDefault(rName, content, retainUntilDate string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
+
+  object_lock_enabled = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_object_lock_configuration" "test" {
+  bucket = aws_s3_bucket_versioning.test.bucket
+
+  rule {
+    default_retention {
+      mode = "GOVERNANCE"
+      days = 1
+    }
+  }
 }
 
 resource "aws_s3_object" "object" {
-  bucket       = aws_s3_bucket.test.bucket
-  key          = "test-key"
-  source       = %[2]q
-  content_type = "binary/octet-stream"
+  bucket                                    = aws_s3_bucket_object_lock_configuration.test.bucket
+  key                                       = "test-key"
+  content                                   = %[2]q
+  force_destroy_bypass_governance_retention = true
+  object_lock_mode                          = %[3]q != "" ? "GOVERNANCE" : null
+  object_lock_retain_until_date             = %[3]q != "" ? %[3]q : null
 }
-`, rName, source)
+`, rName, content, retainUntilDate)
 }
 
-func testAccObjectConfig_contentCharacteristics(rName string, source string) string {
+func testAccObjectConfig_forceDestroyBypassGovernanceRetention(rName, retainUntilDate string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
+
+  object_lock_enabled = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
 }
 
 resource "aws_s3_object" "object" {
-  bucket           = aws_s3_bucket.test.bucket
-  key              = "test-key"
-  source           = %[2]q
-  content_language = "en"
-  content_type     = "binary/octet-stream"
-  website_redirect = "http://google.com"
+  # Must have bucket versioning enabled first
+  bucket                                    = aws_s3_bucket_versioning.test.bucket
+  key                                       = "test-key"
+  content                                   = "stuff"
+  force_destroy_bypass_governance_retention = true
+  object_lock_mode                          = "GOVERNANCE"
+  object_lock_retain_until_date             = %[2]q
 }
-`, rName, source)
+`, rName, retainUntilDate)
 }
 
-func testAccObjectConfig_content(rName string, content string) string {
+func testAccObjectConfig_keyContentSHA256(rName, content string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
@@ -2581,541 +9081,633 @@ resource "aws_s3_bucket" "test" {
 
 resource "aws_s3_object" "object" {
   bucket  = aws_s3_bucket.test.bucket
-  key     = "test-key"
+  key     = "assets/$${content_sha256}.txt"
   content = %[2]q
 }
 `, rName, content)
 }
 
-func testAccObjectConfig_etagEncryption(rName string, source string) string {
+func testAccObjectConfig_bypassGovernanceRetentionConfirmation(rName, retainUntilDate, confirmation string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
+
+  object_lock_enabled = true
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
 }
 
 resource "aws_s3_object" "object" {
-  bucket                 = aws_s3_bucket.test.bucket
-  key                    = "test-key"
-  server_side_encryption = "AES256"
-  source                 = %[2]q
-  etag                   = filemd5(%[2]q)
+  # Must have bucket versioning enabled first
+  bucket                                   = aws_s3_bucket_versioning.test.bucket
+  key                                      = "test-key"
+  content                                  = "stuff"
+  bypass_governance_retention_confirmation = %[3]q != "" ? %[3]q : null
+  object_lock_mode                         = "GOVERNANCE"
+  object_lock_retain_until_date            = %[2]q
+}
+`, rName, retainUntilDate, confirmation)
+}
+
+func testAccObjectConfig_nonVersioned(rName string, source string) string {
+	policy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowYeah",
+      "Effect": "Allow",
+      "Action": "s3:*",
+      "Resource": "*"
+    },
+    {
+      "Sid": "DenyStm1",
+      "Effect": "Deny",
+      "Action": [
+        "s3:GetObjectVersion*",
+        "s3:ListBucketVersions"
+      ],
+      "Resource": "*"
+    }
+  ]
+}`
+
+	return acctest.ConfigAssumeRolePolicy(policy) + fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket_3" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.object_bucket_3.bucket
+  key    = "updateable-key"
+  source = %[2]q
+  etag   = filemd5(%[2]q)
 }
 `, rName, source)
 }
 
-func testAccObjectConfig_contentBase64(rName string, contentBase64 string) string {
-	return fmt.Sprintf(`
+func testAccObjectConfig_crossAccountBucketOwnerFullControl(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
 resource "aws_s3_bucket" "test" {
-  bucket = %[1]q
+  provider = "awsalternate"
+
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_ownership_controls" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    object_ownership = "BucketOwnerPreferred"
+  }
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  provider = "awsalternate"
+
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid    = "AllowWriterAccount"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "s3:*"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }, {
+      Sid    = "RequireBucketOwnerFullControl"
+      Effect = "Deny"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = "s3:PutObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      Condition = {
+        StringNotEquals = {
+          "s3:x-amz-acl" = "bucket-owner-full-control"
+        }
+      }
+    }]
+  })
 }
 
 resource "aws_s3_object" "object" {
-  bucket         = aws_s3_bucket.test.bucket
-  key            = "test-key"
-  content_base64 = %[2]q
+  depends_on = [
+    aws_s3_bucket_ownership_controls.test,
+    aws_s3_bucket_policy.test,
+  ]
+
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "cross-account"
+  acl     = "bucket-owner-full-control"
 }
-`, rName, contentBase64)
+`, rName))
+}
+
+func testAccObjectConfig_bucketKeyEnabled(rName string, content string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "Encrypts test objects"
+  deletion_window_in_days = 7
 }
 
-func testAccObjectConfig_sourceHashTrigger(rName string, source string) string {
-	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
 resource "aws_s3_object" "object" {
-  bucket      = aws_s3_bucket.test.bucket
-  key         = "test-key"
-  source      = %[2]q
-  source_hash = filemd5(%[2]q)
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  content            = %[2]q
+  kms_key_id         = aws_kms_key.test.arn
+  bucket_key_enabled = true
 }
-`, rName, source)
+`, rName, content)
 }
 
-func testAccObjectConfig_updateable(rName string, bucketVersioning bool, source string) string {
+func testAccObjectConfig_bucketBucketKeyEnabled(rName string, content string) string {
 	return fmt.Sprintf(`
-resource "aws_s3_bucket" "object_bucket_3" {
+resource "aws_kms_key" "test" {
+  description             = "Encrypts test objects"
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_bucket_versioning" "object_bucket_3" {
-  bucket = aws_s3_bucket.object_bucket_3.id
-  versioning_configuration {
-    status = "Enabled"
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = aws_kms_key.test.arn
+      sse_algorithm     = "aws:kms"
+    }
+    bucket_key_enabled = true
   }
 }
 
 resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket = aws_s3_bucket_versioning.object_bucket_3.bucket
-  key    = "updateable-key"
-  source = %[3]q
-  etag   = filemd5(%[3]q)
-}
-`, rName, bucketVersioning, source)
-}
+  # Must have bucket SSE enabled first
+  depends_on = [aws_s3_bucket_server_side_encryption_configuration.test]
 
-func testAccObjectConfig_updateableViaAccessPoint(rName string, source string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
-resource "aws_s3_object" "test" {
-  bucket = aws_s3_access_point.test.arn
-  key    = "updateable-key"
-  source = %[1]q
-  etag   = filemd5(%[1]q)
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = %[2]q
 }
-`, source))
+`, rName, content)
 }
 
-func testAccObjectConfig_kmsID(rName string, source string) string {
+func testAccObjectConfig_bucketBucketKeyEnabledOverride(rName, content string, bucketKeyEnabled bool) string {
 	return fmt.Sprintf(`
-resource "aws_kms_key" "kms_key_1" {}
+resource "aws_kms_key" "test" {
+  description             = "Encrypts test objects"
+  deletion_window_in_days = 7
+}
 
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_object" "object" {
-  bucket     = aws_s3_bucket.test.bucket
-  key        = "test-key"
-  source     = %[2]q
-  kms_key_id = aws_kms_key.kms_key_1.arn
-}
-`, rName, source)
-}
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
 
-func testAccObjectConfig_sse(rName string, source string) string {
-	return fmt.Sprintf(`
-resource "aws_s3_bucket" "test" {
-  bucket = %[1]q
+  rule {
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = aws_kms_key.test.arn
+      sse_algorithm     = "aws:kms"
+    }
+    bucket_key_enabled = true
+  }
 }
 
 resource "aws_s3_object" "object" {
-  bucket                 = aws_s3_bucket.test.bucket
-  key                    = "test-key"
-  source                 = %[2]q
-  server_side_encryption = "AES256"
+  # Must have bucket SSE enabled first
+  depends_on = [aws_s3_bucket_server_side_encryption_configuration.test]
+
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  content            = %[2]q
+  bucket_key_enabled = %[3]t
 }
-`, rName, source)
+`, rName, content, bucketKeyEnabled)
 }
 
-func testAccObjectConfig_acl(rName, content, acl string, blockPublicAccess bool) string {
+func testAccObjectConfig_defaultBucketSSE(rName string, content string) string {
 	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "Encrypts test objects"
+  deletion_window_in_days = 7
+}
+
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_bucket_public_access_block" "test" {
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
   bucket = aws_s3_bucket.test.id
 
-  block_public_acls       = %[4]t
-  block_public_policy     = %[4]t
-  ignore_public_acls      = %[4]t
-  restrict_public_buckets = %[4]t
-}
-
-resource "aws_s3_bucket_ownership_controls" "test" {
-  bucket = aws_s3_bucket.test.id
   rule {
-    object_ownership = "BucketOwnerPreferred"
-  }
-}
-
-resource "aws_s3_bucket_versioning" "test" {
-  bucket = aws_s3_bucket.test.id
-  versioning_configuration {
-    status = "Enabled"
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = aws_kms_key.test.arn
+      sse_algorithm     = "aws:kms"
+    }
   }
 }
 
 resource "aws_s3_object" "object" {
-  depends_on = [
-    aws_s3_bucket_public_access_block.test,
-    aws_s3_bucket_ownership_controls.test,
-    aws_s3_bucket_versioning.test,
-  ]
+  # Must have bucket SSE enabled first
+  depends_on = [aws_s3_bucket_server_side_encryption_configuration.test]
 
-  bucket  = aws_s3_bucket.test.id
+  bucket  = aws_s3_bucket.test.bucket
   key     = "test-key"
   content = %[2]q
-  acl     = %[3]q
 }
-`, rName, content, acl, blockPublicAccess)
+`, rName, content)
 }
 
-func testAccObjectConfig_storageClass(rName string, storage_class string) string {
+func testAccObjectConfig_checksumAlgorithm(rName, checksumAlgorithm string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
 resource "aws_s3_object" "object" {
-  bucket        = aws_s3_bucket.test.bucket
-  key           = "test-key"
-  content       = "some_bucket_content"
-  storage_class = %[2]q
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+  checksum_algorithm = %[2]q
 }
-`, rName, storage_class)
+`, rName, checksumAlgorithm)
 }
 
-func testAccObjectConfig_tags(rName, key, content string) string {
+func testAccObjectConfig_defaultChecksumsMatchBucketObject(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_bucket_versioning" "test" {
-  bucket = aws_s3_bucket.test.id
-  versioning_configuration {
-    status = "Enabled"
-  }
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 }
 
-resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket  = aws_s3_bucket_versioning.test.bucket
-  key     = %[2]q
-  content = %[3]q
+resource "aws_s3_bucket_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-bucket-object-key"
+  content = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+}
 
-  tags = {
-    Key1 = "A@AA"
-    Key2 = "BBB"
-    Key3 = "CCC"
-  }
+data "aws_s3_object" "object" {
+  bucket        = aws_s3_object.object.bucket
+  key           = aws_s3_object.object.key
+  checksum_mode = "ENABLED"
 }
-`, rName, key, content)
+
+data "aws_s3_object" "bucket_object" {
+  bucket        = aws_s3_bucket_object.object.bucket
+  key           = aws_s3_bucket_object.object.key
+  checksum_mode = "ENABLED"
+}
+`, rName)
 }
 
-func testAccObjectConfig_updatedTags(rName, key, content string) string {
+func testAccObjectConfig_checksumType(rName, source, checksumAlgorithm, checksumType string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_bucket_versioning" "test" {
-  bucket = aws_s3_bucket.test.id
-  versioning_configuration {
-    status = "Enabled"
-  }
-}
-
 resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket  = aws_s3_bucket_versioning.test.bucket
-  key     = %[2]q
-  content = %[3]q
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
 
-  tags = {
-    Key2 = "B@BB"
-    Key3 = "X X"
-    Key4 = "DDD"
-    Key5 = "E:/"
-  }
+  checksum_algorithm = %[3]q
+  checksum_type      = %[4]q
 }
-`, rName, key, content)
+`, rName, source, checksumAlgorithm, checksumType)
 }
 
-func testAccObjectConfig_noTags(rName, key, content string) string {
+func testAccObjectConfig_providerDefaultChecksumAlgorithm(rName, defaultChecksumAlgorithm, content, checksumAlgorithm string) string {
+	//lintignore:AT004
 	return fmt.Sprintf(`
-resource "aws_s3_bucket" "test" {
-  bucket = %[1]q
+provider "aws" {
+  s3_default_checksum_algorithm = %[2]q
 }
 
-resource "aws_s3_bucket_versioning" "test" {
-  bucket = aws_s3_bucket.test.id
-  versioning_configuration {
-    status = "Enabled"
-  }
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
 resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket  = aws_s3_bucket_versioning.test.bucket
-  key     = %[2]q
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
   content = %[3]q
+
+  checksum_algorithm = %[4]q != "" ? %[4]q : null
 }
-`, rName, key, content)
+`, rName, defaultChecksumAlgorithm, content, checksumAlgorithm)
 }
 
-func testAccObjectConfig_tagsWithOverride(rName, key, content string) string {
+func testAccObjectConfig_verifyChecksum(rName, checksumAlgorithm string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_bucket_versioning" "test" {
-  bucket = aws_s3_bucket.test.id
-  versioning_configuration {
-    status = "Enabled"
-  }
-}
-
 resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket  = aws_s3_bucket_versioning.test.bucket
-  key     = %[2]q
-  content = %[3]q
-
-  tags = {
-    Key1 = "A@AA"
-    Key2 = "BBB"
-    Key3 = "CCC"
-  }
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key"
+  content = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
-  override_provider {
-    default_tags {
-      tags = {}
-    }
-  }
-}
-`, rName, key, content)
+  checksum_algorithm = %[2]s
+  verify_checksum    = true
 }
-
-func testAccObjectConfig_updatedTagsWithOverride(rName, key, content string) string {
-	return fmt.Sprintf(`
-resource "aws_s3_bucket" "test" {
-  bucket = %[1]q
+`, rName, checksumAlgorithm)
 }
 
-resource "aws_s3_bucket_versioning" "test" {
-  bucket = aws_s3_bucket.test.id
-  versioning_configuration {
-    status = "Enabled"
-  }
+func testAccObjectConfig_detectContentType(rName, key, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
 resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket  = aws_s3_bucket_versioning.test.bucket
+  bucket  = aws_s3_bucket.test.bucket
   key     = %[2]q
   content = %[3]q
 
-  tags = {
-    Key2 = "B@BB"
-    Key3 = "X X"
-    Key4 = "DDD"
-    Key5 = "E:/"
-  }
-
-  override_provider {
-    default_tags {
-      tags = {}
-    }
-  }
+  detect_content_type = true
 }
 `, rName, key, content)
 }
 
-func testAccObjectConfig_tags1(rName, key, tagKey1, tagValue1 string) string {
+func testAccObjectConfig_sourceNoContentType(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_bucket_versioning" "test" {
-  bucket = aws_s3_bucket.test.id
-  versioning_configuration {
-    status = "Enabled"
-  }
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
+}
+`, rName, source)
+}
+
+func testAccObjectConfig_contentTypeReference(rName string, detectContentType bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
 resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket = aws_s3_bucket_versioning.test.bucket
-  key    = %[2]q
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test-key.json"
+  content = "{}"
 
-  tags = {
-    %[3]q = %[4]q
+  detect_content_type = %[2]t
+}
+
+locals {
+  cache_control = {
+    "application/json"         = "max-age=300"
+    "application/octet-stream" = "no-store"
   }
 }
-`, rName, key, tagKey1, tagValue1)
+
+resource "aws_s3_object" "dependent" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "dependent-key"
+  content = "dependent"
+
+  cache_control = lookup(local.cache_control, aws_s3_object.object.content_type, "no-cache")
+}
+`, rName, detectContentType)
 }
 
-func testAccObjectConfig_tags2(rName, key, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+func testAccObjectConfig_contentBase64HashOnly(rName, contentBase64 string, hashOnly bool) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_bucket_versioning" "test" {
-  bucket = aws_s3_bucket.test.id
-  versioning_configuration {
-    status = "Enabled"
-  }
-}
-
 resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket = aws_s3_bucket_versioning.test.bucket
-  key    = %[2]q
+  bucket         = aws_s3_bucket.test.bucket
+  key            = "test-key"
+  content_base64 = %[2]q
 
-  tags = {
-    %[3]q = %[4]q
-    %[5]q = %[6]q
-  }
+  content_base64_hash_only = %[3]t
 }
-`, rName, key, tagKey1, tagValue1, tagKey2, tagValue2)
+`, rName, contentBase64, hashOnly)
 }
 
-func testAccObjectConfig_tagsViaAccessPointARN(rName, key, content string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
-resource "aws_s3_object" "object" {
-  bucket  = aws_s3_access_point.test.arn
-  key     = %[1]q
-  content = %[2]q
+func testAccObjectConfig_sourceBucket(rName, metadataDirective string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = "%[1]s-source"
+}
 
-  tags = {
-    Key1 = "A@AA"
-    Key2 = "BBB"
-    Key3 = "CCC"
-  }
+resource "aws_s3_object" "source" {
+  bucket       = aws_s3_bucket.source.bucket
+  key          = "source-key"
+  content      = "source content"
+  content_type = "text/plain"
 }
-`, key, content))
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func testAccObjectConfig_updatedTagsViaAccessPointARN(rName, key, content string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
 resource "aws_s3_object" "object" {
-  bucket  = aws_s3_access_point.test.arn
-  key     = %[1]q
-  content = %[2]q
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+
+  source_bucket      = aws_s3_object.source.bucket
+  source_key         = aws_s3_object.source.key
+  metadata_directive = %[2]q
+
+  content_type = "text/plain"
+  metadata = %[2]q == "REPLACE" ? {
+    copied = "true"
+  } : null
 
   tags = {
-    Key2 = "B@BB"
-    Key3 = "X X"
-    Key4 = "DDD"
-    Key5 = "E:/"
+    Name = %[1]q
   }
 }
-`, key, content))
+`, rName, metadataDirective)
 }
 
-func testAccObjectConfig_tagsViaAccessPointAlias(rName, key, content string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
-resource "aws_s3_object" "object" {
-  bucket  = aws_s3_access_point.test.alias
-  key     = %[1]q
-  content = %[2]q
+func testAccObjectConfig_sourceBucketTaggingDirective(rName, taggingDirective string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = "%[1]s-source"
+}
+
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = "source-key"
+  content = "source content"
 
   tags = {
-    Key1 = "A@AA"
-    Key2 = "BBB"
-    Key3 = "CCC"
+    Source = "true"
   }
 }
-`, key, content))
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func testAccObjectConfig_updatedTagsViaAccessPointAlias(rName, key, content string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseAccessPoint(rName), fmt.Sprintf(`
 resource "aws_s3_object" "object" {
-  bucket  = aws_s3_access_point.test.alias
-  key     = %[1]q
-  content = %[2]q
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
 
-  tags = {
-    Key2 = "B@BB"
-    Key3 = "X X"
-    Key4 = "DDD"
-    Key5 = "E:/"
-  }
+  source_bucket     = aws_s3_object.source.bucket
+  source_key        = aws_s3_object.source.key
+  tagging_directive = %[2]q
+
+  tags = %[2]q == "REPLACE" ? {
+    Name = %[1]q
+  } : null
 }
-`, key, content))
+`, rName, taggingDirective)
 }
 
-func testAccObjectConfig_tagsViaMultiRegionAccessPoint(rName, key, content string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseMultiRegionAccessPoint(rName), fmt.Sprintf(`
-resource "aws_s3_object" "object" {
-  bucket  = aws_s3control_multi_region_access_point.test.arn
-  key     = %[1]q
-  content = %[2]q
+func testAccObjectConfig_sourceBucketTaggingDirectiveDefaultTags(rName, taggingDirective string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = "%[1]s-source"
+}
+
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = "source-key"
+  content = "source content"
 
   tags = {
-    Key1 = "A@AA"
-    Key2 = "BBB"
-    Key3 = "CCC"
+    Source       = "true"
+    providerkey1 = "sourcevalue"
   }
 }
-`, key, content))
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func testAccObjectConfig_updatedTagsViaMultiRegionAccessPoint(rName, key, content string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseMultiRegionAccessPoint(rName), fmt.Sprintf(`
 resource "aws_s3_object" "object" {
-  bucket  = aws_s3control_multi_region_access_point.test.arn
-  key     = %[1]q
-  content = %[2]q
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
 
-  tags = {
-    Key2 = "B@BB"
-    Key3 = "X X"
-    Key4 = "DDD"
-    Key5 = "E:/"
-  }
+  source_bucket     = aws_s3_object.source.bucket
+  source_key        = aws_s3_object.source.key
+  tagging_directive = %[2]q
+
+  tags = %[2]q == "REPLACE" ? {
+    Name = %[1]q
+  } : null
 }
-`, key, content))
+`, rName, taggingDirective)
 }
 
-func testAccObjectConfig_tagsViaObjectLambdaAccessPointARN(rName, key, content string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseObjectLambdaAccessPoint(rName), fmt.Sprintf(`
-resource "aws_s3_object" "object" {
-  bucket  = aws_s3control_object_lambda_access_point.test.arn
-  key     = %[1]q
-  content = %[2]q
+func testAccObjectConfig_sourceBucketTaggingDirectiveTags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = "%[1]s-source"
+}
 
-  tags = {
-    Key1 = "A@AA"
-    Key2 = "BBB"
-    Key3 = "CCC"
-  }
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  key     = "source-key"
+  content = "source content"
 }
-`, key, content))
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
-func testAccObjectConfig_updatedTagsViaObjectLambdaAccessPointARN(rName, key, content string) string {
-	return acctest.ConfigCompose(testAccObjectConfig_baseObjectLambdaAccessPoint(rName), fmt.Sprintf(`
 resource "aws_s3_object" "object" {
-  bucket  = aws_s3control_object_lambda_access_point.test.arn
-  key     = %[1]q
-  content = %[2]q
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+
+  source_bucket     = aws_s3_object.source.bucket
+  source_key        = aws_s3_object.source.key
+  tagging_directive = "COPY"
 
   tags = {
-    Key2 = "B@BB"
-    Key3 = "X X"
-    Key4 = "DDD"
-    Key5 = "E:/"
+    Name = %[1]q
   }
 }
-`, key, content))
+`, rName)
 }
 
-func testAccObjectConfig_metadata(rName string, metadataKey1, metadataValue1, metadataKey2, metadataValue2 string) string {
+func testAccObjectConfig_keySpecialCharacters(rName, key string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
 resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = %[2]q
+  content = "special"
+}
+
+resource "aws_s3_object" "copy" {
   bucket = aws_s3_bucket.test.bucket
-  key    = "test-key"
+  key    = "copy-key"
 
-  metadata = {
-    %[2]s = %[3]q
-    %[4]s = %[5]q
-  }
+  source_bucket = aws_s3_object.object.bucket
+  source_key    = aws_s3_object.object.key
 }
-`, rName, metadataKey1, metadataValue1, metadataKey2, metadataValue2)
+`, rName, key)
 }
 
-func testAccObjectConfig_noLockLegalHold(rName string, content string) string {
+func testAccObjectConfig_metadataDirectiveSource(rName, source, contentType string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
+}
 
-  object_lock_enabled = true
+resource "aws_s3_object" "object" {
+  bucket       = aws_s3_bucket.test.bucket
+  key          = "test-key"
+  source       = %[2]q
+  content_type = %[3]q
+
+  metadata_directive = "REPLACE"
+}
+`, rName, source, contentType)
+}
+
+func testAccObjectConfig_metadataDirective(rName, metadataDirective, metadataValue, storageClass string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
 }
 
 resource "aws_s3_bucket_versioning" "test" {
   bucket = aws_s3_bucket.test.id
+
   versioning_configuration {
     status = "Enabled"
   }
@@ -3125,244 +9717,333 @@ resource "aws_s3_object" "object" {
   # Must have bucket versioning enabled first
   bucket        = aws_s3_bucket_versioning.test.bucket
   key           = "test-key"
-  content       = %[2]q
-  force_destroy = true
+  content       = "stuff"
+  content_type  = "text/plain"
+  storage_class = %[4]q
+
+  metadata_directive = %[2]q
+
+  metadata = {
+    key1 = %[3]q
+  }
 }
-`, rName, content)
+`, rName, metadataDirective, metadataValue, storageClass)
 }
 
-func testAccObjectConfig_lockLegalHold(rName string, content, legalHoldStatus string) string {
+func testAccObjectConfig_bodyUpdatesOnlyOnHashChange(rName, content, sourceHash, contentType, tagValue string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
-
-  object_lock_enabled = true
 }
 
 resource "aws_s3_bucket_versioning" "test" {
   bucket = aws_s3_bucket.test.id
+
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "object" {
+  # Must have bucket versioning enabled first
+  bucket       = aws_s3_bucket_versioning.test.bucket
+  key          = "test-key"
+  content      = %[2]q
+  source_hash  = %[3]q
+  content_type = %[4]q
+
+  body_updates_only_on_hash_change = true
+
+  tags = {
+    Key1 = %[5]q
+  }
+}
+`, rName, content, sourceHash, contentType, tagValue)
+}
+
+func testAccObjectConfig_replicationStatus(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "s3.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetReplicationConfiguration", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn]
+    }, {
+      Action   = ["s3:GetObjectVersionForReplication", "s3:GetObjectVersionAcl", "s3:GetObjectVersionTagging"]
+      Effect   = "Allow"
+      Resource = ["${aws_s3_bucket.test.arn}/*"]
+    }, {
+      Action   = ["s3:ReplicateObject", "s3:ReplicateDelete", "s3:ReplicateTags"]
+      Effect   = "Allow"
+      Resource = ["${aws_s3_bucket.destination.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "%[1]s-destination"
+}
+
+resource "aws_s3_bucket_versioning" "destination" {
+  bucket = aws_s3_bucket.destination.id
+
   versioning_configuration {
     status = "Enabled"
   }
 }
 
-resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket                        = aws_s3_bucket_versioning.test.bucket
-  key                           = "test-key"
-  content                       = %[2]q
-  object_lock_legal_hold_status = %[3]q
-  force_destroy                 = true
-}
-`, rName, content, legalHoldStatus)
-}
-
-func testAccObjectConfig_noLockRetention(rName string, content string) string {
-	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
-
-  object_lock_enabled = true
 }
 
 resource "aws_s3_bucket_versioning" "test" {
   bucket = aws_s3_bucket.test.id
+
   versioning_configuration {
     status = "Enabled"
   }
 }
 
+resource "aws_s3_bucket_replication_configuration" "test" {
+  depends_on = [
+    aws_iam_role_policy.test,
+    aws_s3_bucket_versioning.destination,
+  ]
+
+  bucket = aws_s3_bucket_versioning.test.bucket
+  role   = aws_iam_role.test.arn
+
+  rule {
+    id     = "test"
+    status = "Enabled"
+
+    filter {}
+
+    delete_marker_replication {
+      status = "Disabled"
+    }
+
+    destination {
+      bucket = aws_s3_bucket.destination.arn
+    }
+  }
+}
+
 resource "aws_s3_object" "object" {
-  # Must have bucket versioning enabled first
-  bucket        = aws_s3_bucket_versioning.test.bucket
-  key           = "test-key"
-  content       = %[2]q
-  force_destroy = true
+  # Must have the replication configuration first
+  bucket  = aws_s3_bucket_replication_configuration.test.bucket
+  key     = "test-key"
+  content = "replicated"
 }
-`, rName, content)
+`, rName)
 }
 
-func testAccObjectConfig_lockRetention(rName string, content, retainUntilDate string) string {
+func testAccObjectConfig_deleteSpecificVersionBucketOnly(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
-  bucket = %[1]q
-
-  object_lock_enabled = true
+  bucket        = %[1]q
+  force_destroy = true
 }
 
 resource "aws_s3_bucket_versioning" "test" {
   bucket = aws_s3_bucket.test.id
+
   versioning_configuration {
     status = "Enabled"
   }
 }
+`, rName)
+}
 
+func testAccObjectConfig_deleteSpecificVersion(rName, content string, deleteSpecificVersion bool) string {
+	return acctest.ConfigCompose(testAccObjectConfig_deleteSpecificVersionBucketOnly(rName), fmt.Sprintf(`
 resource "aws_s3_object" "object" {
   # Must have bucket versioning enabled first
-  bucket                        = aws_s3_bucket_versioning.test.bucket
-  key                           = "test-key"
-  content                       = %[2]q
-  force_destroy                 = true
-  object_lock_mode              = "GOVERNANCE"
-  object_lock_retain_until_date = %[3]q
-}
-`, rName, content, retainUntilDate)
-}
-
-func testAccObjectConfig_nonVersioned(rName string, source string) string {
-	policy := `{
-  "Version": "2012-10-17",
-  "Statement": [
-    {
-      "Sid": "AllowYeah",
-      "Effect": "Allow",
-      "Action": "s3:*",
-      "Resource": "*"
-    },
-    {
-      "Sid": "DenyStm1",
-      "Effect": "Deny",
-      "Action": [
-        "s3:GetObjectVersion*",
-        "s3:ListBucketVersions"
-      ],
-      "Resource": "*"
-    }
-  ]
-}`
-
-	return acctest.ConfigAssumeRolePolicy(policy) + fmt.Sprintf(`
-resource "aws_s3_bucket" "object_bucket_3" {
-  bucket = %[1]q
-}
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "test-key"
+  content = %[1]q
 
-resource "aws_s3_object" "object" {
-  bucket = aws_s3_bucket.object_bucket_3.bucket
-  key    = "updateable-key"
-  source = %[2]q
-  etag   = filemd5(%[2]q)
+  delete_specific_version = %[2]t
 }
-`, rName, source)
+`, content, deleteSpecificVersion))
 }
 
-func testAccObjectConfig_bucketKeyEnabled(rName string, content string) string {
+func testAccObjectConfig_checksumMode(rName string) string {
 	return fmt.Sprintf(`
-resource "aws_kms_key" "test" {
-  description             = "Encrypts test objects"
-  deletion_window_in_days = 7
-}
-
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
+resource "aws_s3_object" "source" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "source-key"
+  content = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+  checksum_algorithm = "SHA256"
+}
+
 resource "aws_s3_object" "object" {
-  bucket             = aws_s3_bucket.test.bucket
-  key                = "test-key"
-  content            = %[2]q
-  kms_key_id         = aws_kms_key.test.arn
-  bucket_key_enabled = true
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+
+  source_bucket = aws_s3_object.source.bucket
+  source_key    = aws_s3_object.source.key
+
+  checksum_mode = "ENABLED"
 }
-`, rName, content)
+`, rName)
 }
 
-func testAccObjectConfig_bucketBucketKeyEnabled(rName string, content string) string {
+func testAccObjectConfig_expiration(rName string) string {
 	return fmt.Sprintf(`
-resource "aws_kms_key" "test" {
-  description             = "Encrypts test objects"
-  deletion_window_in_days = 7
-}
-
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
-  bucket = aws_s3_bucket.test.id
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
 
   rule {
-    apply_server_side_encryption_by_default {
-      kms_master_key_id = aws_kms_key.test.arn
-      sse_algorithm     = "aws:kms"
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {
+      prefix = "expiring/"
+    }
+
+    expiration {
+      days = 365
     }
-    bucket_key_enabled = true
   }
 }
 
 resource "aws_s3_object" "object" {
-  # Must have bucket SSE enabled first
-  depends_on = [aws_s3_bucket_server_side_encryption_configuration.test]
+  # S3 only returns the object's expiration once the lifecycle configuration is in place.
+  depends_on = [aws_s3_bucket_lifecycle_configuration.test]
 
   bucket  = aws_s3_bucket.test.bucket
-  key     = "test-key"
-  content = %[2]q
+  key     = "expiring/test-key"
+  content = "expiring"
 }
-`, rName, content)
+`, rName)
 }
 
-func testAccObjectConfig_defaultBucketSSE(rName string, content string) string {
+func testAccObjectConfig_keyWithSlashes(rName string) string {
 	return fmt.Sprintf(`
-resource "aws_kms_key" "test" {
-  description             = "Encrypts test objects"
-  deletion_window_in_days = 7
-}
-
 resource "aws_s3_bucket" "test" {
   bucket = %[1]q
 }
 
-resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
-  bucket = aws_s3_bucket.test.id
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "/a/b//c///d/////e/"
+}
+`, rName)
+}
 
-  rule {
-    apply_server_side_encryption_by_default {
-      kms_master_key_id = aws_kms_key.test.arn
-      sse_algorithm     = "aws:kms"
-    }
+func testAccObjectConfig_directoryBucket(rName string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_base(rName), `
+resource "aws_s3_directory_bucket" "test" {
+  bucket = local.bucket
+
+  location {
+    name = local.location_name
   }
+
+  force_destroy = true
 }
 
 resource "aws_s3_object" "object" {
-  # Must have bucket SSE enabled first
-  depends_on = [aws_s3_bucket_server_side_encryption_configuration.test]
+  bucket = aws_s3_directory_bucket.test.bucket
+  key    = "test-key"
 
-  bucket  = aws_s3_bucket.test.bucket
-  key     = "test-key"
-  content = %[2]q
+  override_provider {
+    default_tags {
+      tags = {}
+    }
+  }
 }
-`, rName, content)
+`)
 }
 
-func testAccObjectConfig_checksumAlgorithm(rName, checksumAlgorithm string) string {
-	return fmt.Sprintf(`
-resource "aws_s3_bucket" "test" {
-  bucket = %[1]q
+func testAccObjectConfig_directoryBucketAppend(rName, content string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_bucket" "test" {
+  bucket = local.bucket
+
+  location {
+    name = local.location_name
+  }
+
+  force_destroy = true
 }
 
 resource "aws_s3_object" "object" {
-  bucket  = aws_s3_bucket.test.bucket
+  bucket  = aws_s3_directory_bucket.test.bucket
   key     = "test-key"
-  content = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+  content = %[1]q
+  append  = true
 
-  checksum_algorithm = %[2]q
+  override_provider {
+    default_tags {
+      tags = {}
+    }
+  }
 }
-`, rName, checksumAlgorithm)
+`, content))
 }
 
-func testAccObjectConfig_keyWithSlashes(rName string) string {
-	return fmt.Sprintf(`
-resource "aws_s3_bucket" "test" {
-  bucket = %[1]q
+func testAccObjectConfig_directoryBucketContentType(rName, contentType string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_bucket" "test" {
+  bucket = local.bucket
+
+  location {
+    name = local.location_name
+  }
+
+  force_destroy = true
 }
 
 resource "aws_s3_object" "object" {
-  bucket = aws_s3_bucket.test.bucket
-  key    = "/a/b//c///d/////e/"
+  bucket             = aws_s3_directory_bucket.test.bucket
+  key                = "test-key"
+  content            = "stuff"
+  content_type       = %[1]q
+  metadata_directive = "REPLACE"
+
+  override_provider {
+    default_tags {
+      tags = {}
+    }
+  }
 }
-`, rName)
+`, contentType))
 }
 
-func testAccObjectConfig_directoryBucket(rName string) string {
+func testAccObjectConfig_directoryBucketWebsiteRedirect(rName string) string {
 	return acctest.ConfigCompose(testAccDirectoryBucketConfig_base(rName), `
 resource "aws_s3_directory_bucket" "test" {
   bucket = local.bucket
@@ -3375,8 +10056,12 @@ resource "aws_s3_directory_bucket" "test" {
 }
 
 resource "aws_s3_object" "object" {
-  bucket = aws_s3_directory_bucket.test.bucket
-  key    = "test-key"
+  bucket             = aws_s3_directory_bucket.test.bucket
+  key                = "test-key"
+  content            = "stuff"
+  content_type       = "application/json"
+  metadata_directive = "REPLACE"
+  website_redirect   = "/index.html"
 
   override_provider {
     default_tags {
@@ -3387,6 +10072,23 @@ resource "aws_s3_object" "object" {
 `)
 }
 
+func testAccObjectConfig_appendGeneralPurposeBucket(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = %[1]q
+  key     = "test-key"
+  content = "line 1"
+  append  = true
+
+  depends_on = [aws_s3_bucket.test]
+}
+`, rName)
+}
+
 func testAccObjectConfig_prefix(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -3432,3 +10134,28 @@ resource "aws_s3_object" "object" {
 }
 `, rName))
 }
+
+func testAccObjectConfig_tagsFromKeyPattern(rName, key, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = %[2]q
+  content = "stuff"
+
+  tags = {
+    Key1 = %[3]q
+  }
+
+  tags_from_key_pattern {
+    pattern = "^tenants/([^/]+)/"
+    tags = {
+      Tenant = "$1"
+    }
+  }
+}
+`, rName, key, tagValue)
+}
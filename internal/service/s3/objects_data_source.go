@@ -5,6 +5,7 @@ package s3
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -44,10 +46,19 @@ func dataSourceObjects() *schema.Resource {
 				Optional:         true,
 				ValidateDiagFunc: enum.Validate[types.EncodingType](),
 			},
+			"etags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"fetch_owner": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"fetch_version_ids": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -80,6 +91,11 @@ func dataSourceObjects() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"version_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -134,7 +150,7 @@ func dataSourceObjectsRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	var nKeys int64
-	var commonPrefixes, keys, owners []string
+	var commonPrefixes, etags, keys, owners []string
 	var requestCharged string
 
 	pages := s3.NewListObjectsV2Paginator(conn, input)
@@ -158,6 +174,8 @@ pageLoop:
 			}
 
 			keys = append(keys, aws.ToString(v.Key))
+			// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+			etags = append(etags, strings.Trim(aws.ToString(v.ETag), `"`))
 
 			if v := v.Owner; v != nil {
 				owners = append(owners, aws.ToString(v.ID))
@@ -167,11 +185,64 @@ pageLoop:
 		}
 	}
 
+	var versionIDs []string
+	if d.Get("fetch_version_ids").(bool) && len(keys) > 0 {
+		versionsInput := &s3.ListObjectVersionsInput{
+			Bucket:       input.Bucket,
+			Delimiter:    input.Delimiter,
+			EncodingType: input.EncodingType,
+			KeyMarker:    input.StartAfter,
+			Prefix:       input.Prefix,
+			RequestPayer: input.RequestPayer,
+		}
+
+		var err error
+		versionIDs, err = findLatestObjectVersionIDs(ctx, conn, versionsInput, keys, optFns...)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing S3 Bucket (%s) Object versions: %s", bucket, err)
+		}
+	}
+
 	d.SetId(bucket)
 	d.Set("common_prefixes", commonPrefixes)
+	d.Set("etags", etags)
 	d.Set("keys", keys)
 	d.Set("owners", owners)
 	d.Set("request_charged", requestCharged)
+	d.Set("version_ids", versionIDs)
 
 	return diags
 }
+
+// findLatestObjectVersionIDs returns the IDs of the current versions of the specified keys, in the same order.
+// Objects written before the bucket's versioning was enabled have the version ID "null".
+func findLatestObjectVersionIDs(ctx context.Context, conn *s3.Client, input *s3.ListObjectVersionsInput, keys []string, optFns ...func(*s3.Options)) ([]string, error) {
+	versionIDs := make(map[string]string, len(keys))
+	for _, v := range keys {
+		versionIDs[v] = ""
+	}
+	nFound := 0
+
+	pages := s3.NewListObjectVersionsPaginator(conn, input)
+	for pages.HasMorePages() && nFound < len(versionIDs) {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// A key's current version is listed before its other versions.
+		for _, v := range page.Versions {
+			key := aws.ToString(v.Key)
+			if versionID, ok := versionIDs[key]; ok && versionID == "" && aws.ToBool(v.IsLatest) {
+				versionIDs[key] = aws.ToString(v.VersionId)
+				nFound++
+			}
+		}
+	}
+
+	return tfslices.ApplyToAll(keys, func(v string) string {
+		return versionIDs[v]
+	}), nil
+}
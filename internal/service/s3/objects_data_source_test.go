@@ -75,8 +75,41 @@ func TestAccS3ObjectsDataSource_prefixes(t *testing.T) {
 				Config: testAccObjectsDataSourceConfig_prefixes(rName, 1),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "etags.#", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "owners.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "version_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsDataSource_nestedPrefixVersionIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                  func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:                acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories:  acctest.ProtoV5ProviderFactories,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsDataSourceConfig_nestedPrefixVersionIDs(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.0", "prefix1/sub1/nested/"),
+					resource.TestCheckResourceAttr(dataSourceName, "etags.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etags.0", "aws_s3_object.test.0", "etag"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "etags.1", "aws_s3_object.test.1", "etag"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "prefix1/sub1/0"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.1", "prefix1/sub1/1"),
+					resource.TestCheckResourceAttr(dataSourceName, "version_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version_ids.0", "aws_s3_object.test.0", "version_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version_ids.1", "aws_s3_object.test.1", "version_id"),
 				),
 			},
 		},
@@ -313,6 +346,47 @@ data "aws_s3_objects" "test" {
 `)
 }
 
+func testAccObjectsDataSourceConfig_nestedPrefixVersionIDs(rName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_object" "test" {
+  count = %[2]d
+
+  # Must have bucket versioning enabled first
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "prefix1/sub1/${count.index}"
+  content = "ABCDEFGHIJKLMNOPQRSTUVWXYZ${count.index}"
+}
+
+resource "aws_s3_object" "nested" {
+  count = %[2]d
+
+  bucket  = aws_s3_bucket_versioning.test.bucket
+  key     = "prefix1/sub1/nested/${count.index}"
+  content = "0123456789"
+}
+
+data "aws_s3_objects" "test" {
+  bucket            = aws_s3_bucket.test.id
+  prefix            = "prefix1/sub1/"
+  delimiter         = "/"
+  fetch_version_ids = true
+
+  depends_on = [aws_s3_object.test, aws_s3_object.nested]
+}
+`, rName, n)
+}
+
 func testAccObjectsDataSourceConfig_encoded(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
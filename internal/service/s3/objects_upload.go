@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @SDKResource("aws_s3_objects_upload", name="Objects Upload")
+func resourceObjectsUpload() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectsUploadCreate,
+		ReadWithoutTimeout:   resourceObjectsUploadRead,
+		UpdateWithoutTimeout: resourceObjectsUploadUpdate,
+		DeleteWithoutTimeout: resourceObjectsUploadDelete,
+
+		CustomizeDiff: objectsUploadCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.NoZeroValues,
+					validateObjectWriteBucket,
+				),
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"detect_content_type": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateObjectsUploadPattern,
+				},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateObjectsUploadPattern,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceObjectsUploadCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	id := bucket + "/" + keyPrefix
+
+	if err := checkObjectWriteBucket(bucket); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Objects Upload (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if err := syncObjectsUpload(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Objects Upload (%s): %s", id, err)
+	}
+
+	return append(diags, resourceObjectsUploadRead(ctx, d, meta)...)
+}
+
+func resourceObjectsUploadRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := objectsUploadConn(ctx, d, meta)
+
+	bucket := d.Get("bucket").(string)
+	optFns := objectBucketARNOptFns(bucket)
+	err := findBucket(ctx, conn, bucket, optFns...)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Objects Upload (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s): %s", bucket, err)
+	}
+
+	// Objects that were deleted or overwritten outside of Terraform are uploaded again.
+	files := make(map[string]interface{})
+	for key := range d.Get("files").(map[string]interface{}) {
+		output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", string(types.ChecksumAlgorithmCrc32c), optFns...)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 Objects Upload (%s) object (%s): %s", d.Id(), key, err)
+		}
+
+		files[key] = objectsUploadChecksumCRC32C(output.ChecksumCRC32C)
+	}
+
+	d.Set("files", files)
+
+	return diags
+}
+
+func resourceObjectsUploadUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := syncObjectsUpload(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Objects Upload (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceObjectsUploadRead(ctx, d, meta)...)
+}
+
+func resourceObjectsUploadDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := objectsUploadConn(ctx, d, meta)
+
+	bucket := d.Get("bucket").(string)
+	optFns := objectBucketARNOptFns(bucket)
+	for key := range d.Get("files").(map[string]interface{}) {
+		if err := deleteObjectVersion(ctx, conn, bucket, key, "", false, optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting S3 Objects Upload (%s) object (%s): %s", d.Id(), key, err)
+		}
+	}
+
+	return diags
+}
+
+// objectsUploadCustomizeDiff plans the objects to be uploaded from the files in source_dir, and their CRC32C digests.
+func objectsUploadCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"exclude", "include", "key_prefix", "source_dir"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("files")
+		}
+	}
+
+	files, err := findObjectsUploadFiles(d.Get("source_dir").(string), d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("include").(*schema.Set)), flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	digests, err := objectsUploadDigests(files)
+	if err != nil {
+		return err
+	}
+
+	if d.Id() == "" || objectsUploadFilesChanged(d.Get("files").(map[string]interface{}), digests) {
+		return d.SetNew("files", digests)
+	}
+
+	return nil
+}
+
+// syncObjectsUpload uploads the files in source_dir that are new or that differ from their objects, and deletes the objects of files that were removed.
+// The objects of all files are uploaded again when their metadata changes.
+func syncObjectsUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn := objectsUploadConn(ctx, d, meta)
+
+	files, err := findObjectsUploadFiles(d.Get("source_dir").(string), d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("include").(*schema.Set)), flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	digests, err := objectsUploadDigests(files)
+	if err != nil {
+		return err
+	}
+
+	// The digests in the plan are unknown if source_dir wasn't known at plan time.
+	o, n := d.GetChange("files")
+	if planned := n.(map[string]interface{}); len(planned) > 0 && objectsUploadFilesChanged(planned, digests) {
+		return fmt.Errorf("files in source_dir (%s) changed after the plan was made, plan and apply again", d.Get("source_dir").(string))
+	}
+
+	bucket := d.Get("bucket").(string)
+	optFns := objectBucketARNOptFns(bucket)
+	// Objects uploaded in parts get a full-object checksum, instead of a checksum of the parts' checksums, so that Read can compare it with the file's digest.
+	uploader := manager.NewUploader(objectChecksumTypeUploadClient{UploadAPIClient: conn, checksumType: types.ChecksumTypeFullObject}, manager.WithUploaderRequestOptions(optFns...))
+	current := o.(map[string]interface{})
+	uploadAll := d.HasChanges("cache_control", "detect_content_type")
+
+	for key, path := range files {
+		if !uploadAll && current[key] == digests[key] {
+			continue
+		}
+
+		if err := putObjectsUploadFile(ctx, uploader, d, bucket, key, path, digests[key].(string)); err != nil {
+			return fmt.Errorf("uploading S3 Object (%s) from %s: %w", key, path, err)
+		}
+	}
+
+	for key := range current {
+		if _, ok := files[key]; ok {
+			continue
+		}
+
+		if err := deleteObjectVersion(ctx, conn, bucket, key, "", false, optFns...); err != nil {
+			return fmt.Errorf("deleting S3 Object (%s): %w", key, err)
+		}
+	}
+
+	d.Set("files", digests)
+
+	return nil
+}
+
+func putObjectsUploadFile(ctx context.Context, uploader *manager.Uploader, d *schema.ResourceData, bucket, key, path, digest string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	checksum, err := hex.DecodeString(digest)
+	if err != nil {
+		return err
+	}
+
+	// S3 checks the object's CRC32C checksum against the file's digest, and stores it to be read back to detect drift.
+	// A larger file is uploaded in parts. The uploader copies the checksum into CompleteMultipartUpload, where S3 checks it against the full-object checksum combined from the parts' checksums.
+	input := &s3.PutObjectInput{
+		Body:              file,
+		Bucket:            aws.String(bucket),
+		ChecksumAlgorithm: types.ChecksumAlgorithmCrc32c,
+		ChecksumCRC32C:    aws.String(base64.StdEncoding.EncodeToString(checksum)),
+		Key:               aws.String(key),
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if d.Get("detect_content_type").(bool) {
+		v, err := detectObjectContentType(key, path, "", "")
+		if err != nil {
+			return err
+		}
+
+		input.ContentType = aws.String(v)
+	}
+
+	_, err = uploader.Upload(ctx, input)
+
+	return err
+}
+
+func objectsUploadConn(ctx context.Context, d *schema.ResourceData, meta interface{}) *s3.Client {
+	if isDirectoryBucket(d.Get("bucket").(string)) {
+		return meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	return meta.(*conns.AWSClient).S3Client(ctx)
+}
+
+// findObjectsUploadFiles returns the regular files under the specified directory, keyed by object key.
+// A file's key is the key prefix followed by the file's slash-separated path relative to the directory.
+// Files are included if they match any of the include patterns, or if there are none, and don't match any of the exclude patterns.
+func findObjectsUploadFiles(sourceDir, keyPrefix string, includes, excludes []string) (map[string]string, error) {
+	dir, err := homedir.Expand(sourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", sourceDir, err)
+	}
+
+	files := make(map[string]string)
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		// Symbolic links to files are followed, streams and other special files are skipped.
+		fi, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if len(includes) > 0 && !matchObjectsUploadPatterns(includes, rel) {
+			return nil
+		}
+		if matchObjectsUploadPatterns(excludes, rel) {
+			return nil
+		}
+
+		files[keyPrefix+rel] = path
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+// matchObjectsUploadPatterns returns whether the specified slash-separated relative path matches any of the specified patterns.
+// A pattern that contains a slash is matched against the whole path, otherwise it's matched against the file name, so that e.g. "*.tmp" matches files in any directory.
+func matchObjectsUploadPatterns(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateObjectsUploadPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid pattern (%s): %w", k, v.(string), err))
+	}
+
+	return
+}
+
+// objectsUploadDigests returns the hex-encoded CRC32C digests of the specified files.
+func objectsUploadDigests(files map[string]string) (map[string]interface{}, error) {
+	digests := make(map[string]interface{}, len(files))
+	for key, path := range files {
+		digest, err := objectsUploadFileCRC32C(path)
+		if err != nil {
+			return nil, err
+		}
+
+		digests[key] = digest
+	}
+
+	return digests, nil
+}
+
+func objectsUploadFileCRC32C(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash, err := newChecksumHash(types.ChecksumAlgorithmCrc32c)
+	if err != nil {
+		return "", err
+	}
+
+	digest, err := computeObjectDigest(file, hash)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	return hex.EncodeToString(digest), nil
+}
+
+// objectsUploadChecksumCRC32C returns the hex-encoded CRC32C digest of an object from its base64-encoded CRC32C checksum.
+// Objects without a checksum, or with the composite checksum of a multipart upload, e.g. "<base64>-3", have an empty digest.
+// Such objects were uploaded outside of Terraform, and are uploaded again.
+func objectsUploadChecksumCRC32C(checksum *string) string {
+	v, err := base64.StdEncoding.DecodeString(aws.ToString(checksum))
+	if err != nil || len(v) == 0 {
+		return ""
+	}
+
+	return hex.EncodeToString(v)
+}
+
+func objectsUploadFilesChanged(old, new map[string]interface{}) bool {
+	if len(old) != len(new) {
+		return true
+	}
+
+	for k, v := range new {
+		if old[k] != v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestFindObjectsUploadFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{"index.html", "notes.tmp", "css/site.css", "css/site.tmp", "js/app.js"} {
+		testAccObjectsUploadWriteFile(t, dir, name, name)
+	}
+
+	testCases := map[string]struct {
+		keyPrefix string
+		includes  []string
+		excludes  []string
+		expected  []string
+	}{
+		"all files": {
+			expected: []string{"css/site.css", "css/site.tmp", "index.html", "js/app.js", "notes.tmp"},
+		},
+		"key prefix": {
+			keyPrefix: "site/",
+			expected:  []string{"site/css/site.css", "site/css/site.tmp", "site/index.html", "site/js/app.js", "site/notes.tmp"},
+		},
+		"exclude file name": {
+			excludes: []string{"*.tmp"},
+			expected: []string{"css/site.css", "index.html", "js/app.js"},
+		},
+		"exclude path": {
+			excludes: []string{"css/*"},
+			expected: []string{"index.html", "js/app.js", "notes.tmp"},
+		},
+		"include": {
+			includes: []string{"*.html", "js/*.js"},
+			expected: []string{"index.html", "js/app.js"},
+		},
+		"include and exclude": {
+			includes: []string{"css/*"},
+			excludes: []string{"*.tmp"},
+			expected: []string{"css/site.css"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := tfs3.FindObjectsUploadFiles(dir, testCase.keyPrefix, testCase.includes, testCase.excludes)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expected := make(map[string]string)
+			for _, key := range testCase.expected {
+				expected[key] = filepath.Join(dir, filepath.FromSlash(key[len(testCase.keyPrefix):]))
+			}
+
+			if diff := cmp.Diff(files, expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccS3ObjectsUpload_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_objects_upload.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+	testAccObjectsUploadWriteFile(t, dir, "index.html", "<h1>index</h1>")
+	testAccObjectsUploadWriteFile(t, dir, "css/site.css", "body {}")
+	testAccObjectsUploadWriteFile(t, dir, "notes.tmp", "notes")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsUploadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsUploadConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", "0b40afb0"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/css/site.css", "0a6f10c4"),
+					testAccCheckObjectsUploadObject(ctx, rName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckObjectsUploadObject(ctx, rName, "site/css/site.css", "text/css; charset=utf-8"),
+					testAccCheckObjectsUploadNoObject(ctx, rName, "site/notes.tmp"),
+				),
+			},
+			{
+				// Changed files are uploaded again, and the objects of removed files are deleted.
+				PreConfig: func() {
+					testAccObjectsUploadWriteFile(t, dir, "index.html", "<h1>index v2</h1>")
+					testAccObjectsUploadWriteFile(t, dir, "about.html", "about")
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectsUploadConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", "f2ead439"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/about.html", "ac8d61d2"),
+					testAccCheckObjectsUploadObject(ctx, rName, "site/about.html", "text/html; charset=utf-8"),
+					testAccCheckObjectsUploadNoObject(ctx, rName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsUpload_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_objects_upload.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+	testAccObjectsUploadWriteFile(t, dir, "index.html", "<h1>index</h1>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsUploadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsUploadConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckObjectsUploadObject(ctx, rName, "site/index.html", "text/html; charset=utf-8"),
+					// Overwrite the object outside of Terraform.
+					testAccCheckObjectsUploadPutObject(ctx, rName, "site/index.html", "changed"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectsUploadConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", "0b40afb0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsUpload_multipart(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_objects_upload.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+	// Larger than the upload manager's default part size.
+	content := strings.Repeat("0123456789abcdef", 6*1024*1024/16)
+	testAccObjectsUploadWriteFile(t, dir, "index.html", content)
+	digest := crc32.Checksum([]byte(content), crc32.MakeTable(crc32.Castagnoli))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsUploadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The object's full-object checksum is read back, and matches the file's digest.
+				Config: testAccObjectsUploadConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", fmt.Sprintf("%08x", digest)),
+				),
+			},
+			{
+				Config: testAccObjectsUploadConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Overwrite the object outside of Terraform.
+					testAccCheckObjectsUploadPutObject(ctx, rName, "site/index.html", "changed"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectsUpload_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_objects_upload.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := t.TempDir()
+	testAccObjectsUploadWriteFile(t, dir, "index.html", "<h1>index</h1>")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectsUploadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectsUploadConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectsUploadObject(ctx, rName, "site/index.html", "text/html; charset=utf-8"),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceObjectsUpload(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccObjectsUploadWriteFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckObjectsUploadDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_objects_upload" {
+				continue
+			}
+
+			for k := range rs.Primary.Attributes {
+				key, ok := testAccObjectsUploadFileKey(k)
+				if !ok {
+					continue
+				}
+
+				_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Objects Upload %s object %s still exists", rs.Primary.ID, key)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccObjectsUploadFileKey returns the object key of a flatmapped files attribute, e.g. "files.site/index.html".
+func testAccObjectsUploadFileKey(k string) (string, bool) {
+	key, ok := strings.CutPrefix(k, "files.")
+	if !ok || key == "%" {
+		return "", false
+	}
+
+	return key, true
+}
+
+func testAccCheckObjectsUploadObject(ctx context.Context, bucket, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.ContentType), contentType; got != want {
+			return fmt.Errorf("S3 Object (%s) Content-Type = %q, want %q", key, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectsUploadNoObject(ctx context.Context, bucket, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) exists", key)
+	}
+}
+
+// testAccCheckObjectsUploadPutObject replaces the object's content outside of Terraform, without a checksum.
+func testAccCheckObjectsUploadPutObject(ctx context.Context, bucket, key, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		input := &s3.PutObjectInput{
+			Body:   strings.NewReader(content),
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		_, err := conn.PutObject(ctx, input)
+
+		return err
+	}
+}
+
+func testAccObjectsUploadConfig_basic(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_objects_upload" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+  exclude    = ["*.tmp"]
+}
+`, rName, dir)
+}
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+var ruleFilterCmpOpts = cmpopts.IgnoreUnexported(
+	types.LifecycleRuleAndOperator{},
+	types.LifecycleRuleFilter{},
+	types.ReplicationRuleAndOperator{},
+	types.ReplicationRuleFilter{},
+	types.Tag{},
+)
+
+// lifecycleRuleFilterTFList returns a lifecycle rule filter as read from the configuration, with the specified values set.
+func lifecycleRuleFilterTFList(values map[string]interface{}) []interface{} {
+	tfMap := map[string]interface{}{
+		"and":                      []interface{}{},
+		"object_size_greater_than": "",
+		"object_size_less_than":    "",
+		"prefix":                   "",
+		"tag":                      []interface{}{},
+	}
+	for k, v := range values {
+		tfMap[k] = v
+	}
+
+	return []interface{}{tfMap}
+}
+
+func TestExpandLifecycleRuleFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		tfList   []interface{}
+		expected *types.LifecycleRuleFilter
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:   "empty prefix",
+			tfList: lifecycleRuleFilterTFList(nil),
+			expected: &types.LifecycleRuleFilter{
+				Prefix: aws.String(""),
+			},
+		},
+		{
+			name:   "prefix",
+			tfList: lifecycleRuleFilterTFList(map[string]interface{}{"prefix": "logs/"}),
+			expected: &types.LifecycleRuleFilter{
+				Prefix: aws.String("logs/"),
+			},
+		},
+		{
+			name:   "object size greater than",
+			tfList: lifecycleRuleFilterTFList(map[string]interface{}{"object_size_greater_than": "100"}),
+			expected: &types.LifecycleRuleFilter{
+				ObjectSizeGreaterThan: aws.Int64(100),
+			},
+		},
+		{
+			name:   "object size less than",
+			tfList: lifecycleRuleFilterTFList(map[string]interface{}{"object_size_less_than": "200"}),
+			expected: &types.LifecycleRuleFilter{
+				ObjectSizeLessThan: aws.Int64(200),
+			},
+		},
+		{
+			name: "tag",
+			tfList: lifecycleRuleFilterTFList(map[string]interface{}{"tag": []interface{}{map[string]interface{}{
+				"key":   "key1",
+				"value": "value1",
+			}}}),
+			expected: &types.LifecycleRuleFilter{
+				Tag: &types.Tag{
+					Key:   aws.String("key1"),
+					Value: aws.String("value1"),
+				},
+			},
+		},
+		{
+			name: "and",
+			tfList: lifecycleRuleFilterTFList(map[string]interface{}{"and": []interface{}{map[string]interface{}{
+				"object_size_greater_than": 100,
+				"object_size_less_than":    0,
+				"prefix":                   "logs/",
+				"tags":                     map[string]interface{}{"key1": "value1"},
+			}}}),
+			expected: &types.LifecycleRuleFilter{
+				And: &types.LifecycleRuleAndOperator{
+					ObjectSizeGreaterThan: aws.Int64(100),
+					Prefix:                aws.String("logs/"),
+					Tags: []types.Tag{{
+						Key:   aws.String("key1"),
+						Value: aws.String("value1"),
+					}},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandLifecycleRuleFilter(context.Background(), testCase.tfList)
+
+			if diff := cmp.Diff(got, testCase.expected, ruleFilterCmpOpts); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenLifecycleRuleFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		filter   *types.LifecycleRuleFilter
+		expected []interface{}
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:   "no member",
+			filter: &types.LifecycleRuleFilter{},
+		},
+		{
+			name: "prefix",
+			filter: &types.LifecycleRuleFilter{
+				Prefix: aws.String("logs/"),
+			},
+			expected: []interface{}{map[string]interface{}{
+				"prefix": "logs/",
+			}},
+		},
+		{
+			name: "object size greater than",
+			filter: &types.LifecycleRuleFilter{
+				ObjectSizeGreaterThan: aws.Int64(100),
+			},
+			expected: []interface{}{map[string]interface{}{
+				"object_size_greater_than": "100",
+			}},
+		},
+		{
+			name: "object size less than",
+			filter: &types.LifecycleRuleFilter{
+				ObjectSizeLessThan: aws.Int64(200),
+			},
+			expected: []interface{}{map[string]interface{}{
+				"object_size_less_than": "200",
+			}},
+		},
+		{
+			name: "tag",
+			filter: &types.LifecycleRuleFilter{
+				Tag: &types.Tag{
+					Key:   aws.String("key1"),
+					Value: aws.String("value1"),
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"tag": []interface{}{map[string]interface{}{
+					"key":   "key1",
+					"value": "value1",
+				}},
+			}},
+		},
+		{
+			name: "and",
+			filter: &types.LifecycleRuleFilter{
+				And: &types.LifecycleRuleAndOperator{
+					ObjectSizeGreaterThan: aws.Int64(100),
+					Prefix:                aws.String("logs/"),
+					Tags: []types.Tag{{
+						Key:   aws.String("key1"),
+						Value: aws.String("value1"),
+					}},
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"and": []interface{}{map[string]interface{}{
+					"object_size_greater_than": aws.Int64(100),
+					"object_size_less_than":    (*int64)(nil),
+					"prefix":                   "logs/",
+					"tags":                     map[string]string{"key1": "value1"},
+				}},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := flattenLifecycleRuleFilter(context.Background(), testCase.filter)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandReplicationRuleFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		tfList   []interface{}
+		expected *types.ReplicationRuleFilter
+	}{
+		{
+			name: "empty",
+			expected: &types.ReplicationRuleFilter{
+				Prefix: aws.String(""),
+			},
+		},
+		{
+			name: "prefix",
+			tfList: []interface{}{map[string]interface{}{
+				"prefix": "logs/",
+			}},
+			expected: &types.ReplicationRuleFilter{
+				Prefix: aws.String("logs/"),
+			},
+		},
+		{
+			name: "tag",
+			tfList: []interface{}{map[string]interface{}{
+				"prefix": "",
+				"tag": []interface{}{map[string]interface{}{
+					"key":   "key1",
+					"value": "value1",
+				}},
+			}},
+			expected: &types.ReplicationRuleFilter{
+				Tag: &types.Tag{
+					Key:   aws.String("key1"),
+					Value: aws.String("value1"),
+				},
+			},
+		},
+		{
+			name: "and",
+			tfList: []interface{}{map[string]interface{}{
+				"and": []interface{}{map[string]interface{}{
+					"prefix": "logs/",
+					"tags":   map[string]interface{}{"key1": "value1"},
+				}},
+				"prefix": "",
+			}},
+			expected: &types.ReplicationRuleFilter{
+				And: &types.ReplicationRuleAndOperator{
+					Prefix: aws.String("logs/"),
+					Tags: []types.Tag{{
+						Key:   aws.String("key1"),
+						Value: aws.String("value1"),
+					}},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandReplicationRuleFilter(context.Background(), testCase.tfList)
+
+			if diff := cmp.Diff(got, testCase.expected, ruleFilterCmpOpts); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenReplicationRuleFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		filter   *types.ReplicationRuleFilter
+		expected []interface{}
+	}{
+		{
+			name:     "nil",
+			expected: []interface{}{},
+		},
+		{
+			name:   "no member",
+			filter: &types.ReplicationRuleFilter{},
+		},
+		{
+			name: "prefix",
+			filter: &types.ReplicationRuleFilter{
+				Prefix: aws.String("logs/"),
+			},
+			expected: []interface{}{map[string]interface{}{
+				"prefix": "logs/",
+			}},
+		},
+		{
+			name: "tag",
+			filter: &types.ReplicationRuleFilter{
+				Tag: &types.Tag{
+					Key:   aws.String("key1"),
+					Value: aws.String("value1"),
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"tag": []interface{}{map[string]interface{}{
+					"key":   "key1",
+					"value": "value1",
+				}},
+			}},
+		},
+		{
+			name: "and",
+			filter: &types.ReplicationRuleFilter{
+				And: &types.ReplicationRuleAndOperator{
+					Prefix: aws.String("logs/"),
+					Tags: []types.Tag{{
+						Key:   aws.String("key1"),
+						Value: aws.String("value1"),
+					}},
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"and": []interface{}{map[string]interface{}{
+					"prefix": "logs/",
+					"tags":   map[string]string{"key1": "value1"},
+				}},
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := flattenReplicationRuleFilter(context.Background(), testCase.filter)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -27,6 +27,10 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 			o.Region = names.GlobalRegionID
 		}
 		o.UsePathStyle = config["s3_use_path_style"].(bool)
+		// Only calculate and validate checksums when required or requested, e.g. via checksum_algorithm or checksum_mode,
+		// so that uploads to S3-compatible stores that don't support the newer default checksums keep working.
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+		o.ResponseChecksumValidation = aws.ResponseChecksumValidationWhenRequired
 
 		o.Retryer = conns.AddIsErrorRetryables(cfg.Retryer().(aws.RetryerV2), retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
 			if tfawserr.ErrMessageContains(err, errCodeOperationAborted, "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
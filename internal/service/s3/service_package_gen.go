@@ -62,6 +62,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_s3_object",
 			Name:     "Object",
 		},
+		{
+			Factory:  dataSourceObjectPresign,
+			TypeName: "aws_s3_object_presign",
+			Name:     "Object Presign",
+		},
 		{
 			Factory:  dataSourceObjects,
 			TypeName: "aws_s3_objects",
@@ -203,6 +208,20 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "ObjectCopy",
 			},
 		},
+		{
+			Factory:  resourceObjectTags,
+			TypeName: "aws_s3_object_tags",
+			Name:     "Object Tags",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+				ResourceType:        "ObjectTags",
+			},
+		},
+		{
+			Factory:  resourceObjectsUpload,
+			TypeName: "aws_s3_objects_upload",
+			Name:     "Objects Upload",
+		},
 	}
 }
 
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+func TestServicePackageNewClient_checksums(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := aws.Config{
+		Region: "us-west-2", //lintignore:AWSAT003
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard()
+		},
+	}
+
+	client, err := (&servicePackage{}).NewClient(ctx, map[string]any{
+		"aws_sdkv2_config":               &cfg,
+		"endpoint":                       "",
+		"s3_us_east_1_regional_endpoint": "",
+		"s3_use_path_style":              false,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	options := client.Options()
+
+	if got, want := options.RequestChecksumCalculation, aws.RequestChecksumCalculationWhenRequired; got != want {
+		t.Errorf("RequestChecksumCalculation = %v, want %v", got, want)
+	}
+
+	if got, want := options.ResponseChecksumValidation, aws.ResponseChecksumValidationWhenRequired; got != want {
+		t.Errorf("ResponseChecksumValidation = %v, want %v", got, want)
+	}
+}
@@ -82,10 +82,12 @@ func sweepObjects(region string) error {
 
 		if !tfresource.NotFound(err) {
 			if err != nil {
+				// Assume that Object Lock is enabled so that locked objects are still deleted.
 				log.Printf("[WARN] Reading S3 Bucket Object Lock Configuration (%s): %s", bucket, err)
-				continue
+				objectLockEnabled = true
+			} else {
+				objectLockEnabled = objLockConfig.ObjectLockEnabled == types.ObjectLockEnabledEnabled
 			}
-			objectLockEnabled = objLockConfig.ObjectLockEnabled == types.ObjectLockEnabledEnabled
 		}
 
 		sweepables = append(sweepables, objectSweeper{
@@ -139,8 +141,26 @@ type objectSweeper struct {
 
 func (os objectSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
 	// Delete everything including locked objects.
+	if os.locked {
+		// Legal holds are also removed on demand when emptying the bucket, but this requires deleting the object versions one by one.
+		log.Printf("[INFO] Removing S3 Bucket (%s) object legal holds", os.bucket)
+		n, err := forEachObjectVersionsPage(ctx, os.conn, os.bucket, removePageOfObjectVersionsLegalHolds)
+		if err != nil {
+			log.Printf("[WARN] Removing S3 Bucket (%s) object legal holds: %s", os.bucket, err)
+		}
+		log.Printf("[INFO] Removed %d S3 Object legal holds from S3 Bucket (%s)", n, os.bucket)
+	}
+
+	// Incomplete multipart uploads, e.g. left by interrupted test runs, aren't object versions.
+	log.Printf("[INFO] Aborting S3 Bucket (%s) multipart uploads", os.bucket)
+	n, err := abortMultipartUploads(ctx, os.conn, os.bucket)
+	if err != nil {
+		log.Printf("[WARN] Aborting S3 Bucket (%s) multipart uploads: %s", os.bucket, err)
+	}
+	log.Printf("[INFO] Aborted %d S3 multipart uploads in S3 Bucket (%s)", n, os.bucket)
+
 	log.Printf("[INFO] Emptying S3 Bucket (%s)", os.bucket)
-	n, err := emptyBucket(ctx, os.conn, os.bucket, os.locked)
+	n, err = emptyBucket(ctx, os.conn, os.bucket, os.locked)
 	if err != nil {
 		return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", os.bucket, err)
 	}
@@ -154,8 +174,16 @@ type directoryBucketObjectSweeper struct {
 }
 
 func (os directoryBucketObjectSweeper) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	// Directory buckets aren't versioned and don't support Object Lock, but in-progress multipart uploads prevent their deletion.
+	log.Printf("[INFO] Aborting S3 Directory Bucket (%s) multipart uploads", os.bucket)
+	n, err := abortMultipartUploads(ctx, os.conn, os.bucket)
+	if err != nil {
+		return fmt.Errorf("aborting S3 Directory Bucket (%s) multipart uploads: %w", os.bucket, err)
+	}
+	log.Printf("[INFO] Aborted %d S3 multipart uploads in S3 Directory Bucket (%s)", n, os.bucket)
+
 	log.Printf("[INFO] Emptying S3 Directory Bucket (%s)", os.bucket)
-	n, err := emptyDirectoryBucket(ctx, os.conn, os.bucket)
+	n, err = emptyDirectoryBucket(ctx, os.conn, os.bucket)
 	if err != nil {
 		return fmt.Errorf("deleting S3 Directory Bucket (%s) objects: %w", os.bucket, err)
 	}
@@ -8,16 +8,21 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // Custom S3 tag service update functions using the same format as generated code.
@@ -93,11 +98,15 @@ func bucketUpdateTags(ctx context.Context, conn *s3.Client, identifier string, o
 }
 
 // objectListTags lists S3 object tags.
-func objectListTags(ctx context.Context, conn *s3.Client, bucket, key string, optFns ...func(*s3.Options)) (tftags.KeyValueTags, error) {
+// If versionID is empty the tags of the current object version are listed.
+func objectListTags(ctx context.Context, conn *s3.Client, bucket, key, versionID string, optFns ...func(*s3.Options)) (tftags.KeyValueTags, error) {
 	input := &s3.GetObjectTaggingInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 	}
+	if versionID != "" {
+		input.VersionId = aws.String(versionID)
+	}
 
 	output, err := conn.GetObjectTagging(ctx, input, optFns...)
 
@@ -117,12 +126,13 @@ func objectListTags(ctx context.Context, conn *s3.Client, bucket, key string, op
 }
 
 // objectUpdateTags updates S3 object tags.
-func objectUpdateTags(ctx context.Context, conn *s3.Client, bucket, key string, oldTagsMap, newTagsMap any, optFns ...func(*s3.Options)) error {
+// If versionID is empty the tags of the current object version are updated.
+func objectUpdateTags(ctx context.Context, conn *s3.Client, bucket, key, versionID string, oldTagsMap, newTagsMap any, optFns ...func(*s3.Options)) error {
 	oldTags := tftags.New(ctx, oldTagsMap)
 	newTags := tftags.New(ctx, newTagsMap)
 
 	// We need to also consider any existing ignored tags.
-	allTags, err := objectListTags(ctx, conn, bucket, key, optFns...)
+	allTags, err := objectListTags(ctx, conn, bucket, key, versionID, optFns...)
 
 	if err != nil {
 		return fmt.Errorf("listing resource tags (%s/%s): %w", bucket, key, err)
@@ -138,6 +148,9 @@ func objectUpdateTags(ctx context.Context, conn *s3.Client, bucket, key string,
 				TagSet: Tags(newTags.Merge(ignoredTags)),
 			},
 		}
+		if versionID != "" {
+			input.VersionId = aws.String(versionID)
+		}
 
 		_, err := conn.PutObjectTagging(ctx, input, optFns...)
 
@@ -149,6 +162,9 @@ func objectUpdateTags(ctx context.Context, conn *s3.Client, bucket, key string,
 			Bucket: aws.String(bucket),
 			Key:    aws.String(key),
 		}
+		if versionID != "" {
+			input.VersionId = aws.String(versionID)
+		}
 
 		_, err := conn.DeleteObjectTagging(ctx, input, optFns...)
 
@@ -160,6 +176,53 @@ func objectUpdateTags(ctx context.Context, conn *s3.Client, bucket, key string,
 	return nil
 }
 
+// S3 object tagging limits.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html.
+const (
+	objectTagsMaxCount      = 10
+	objectTagKeyMaxLength   = 128
+	objectTagValueMaxLength = 256
+)
+
+// objectTagsCustomizeDiff validates an object's planned tags, including any provider default tags, against the S3 object tagging limits.
+func objectTagsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown(names.AttrTagsAll) {
+		return nil
+	}
+
+	return validateObjectTags(d.Get(names.AttrTagsAll).(map[string]interface{}))
+}
+
+// validateObjectTags returns an error for each of the specified object tags that exceeds the S3 object tagging limits.
+func validateObjectTags(tags map[string]interface{}) error {
+	var errs []error
+
+	if n := len(tags); n > objectTagsMaxCount {
+		errs = append(errs, fmt.Errorf("an S3 object can have at most %d tags, got %d", objectTagsMaxCount, n))
+	}
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		// Lengths are in Unicode characters.
+		if n := utf8.RuneCountInString(k); n > objectTagKeyMaxLength {
+			errs = append(errs, fmt.Errorf("tag key %q is %d characters long, the maximum is %d", k, n, objectTagKeyMaxLength))
+		}
+
+		if v, ok := tags[k].(string); ok {
+			if n := utf8.RuneCountInString(v); n > objectTagValueMaxLength {
+				errs = append(errs, fmt.Errorf("tag %q value is %d characters long, the maximum is %d", k, n, objectTagValueMaxLength))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // ListTags lists s3 service tags and set them in Context.
 // It is called from outside this package.
 func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier, resourceType string) error {
@@ -177,7 +240,20 @@ func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier, res
 		if err != nil {
 			return err
 		}
-		tags, err = objectListTags(ctx, meta.(*conns.AWSClient).S3Client(ctx), objectARN.Bucket, objectARN.Key)
+		err = objectTagsInBucketRegion(ctx, meta, objectARN.Bucket, func(conn *s3.Client, optFns ...func(*s3.Options)) error {
+			var err error
+			tags, err = objectListTags(ctx, conn, objectARN.Bucket, objectARN.Key, "", optFns...)
+			return err
+		})
+
+	case "ObjectTags":
+		var bucket, key, versionID string
+		bucket, key, versionID, err = parseObjectTagsResourceID(identifier)
+		if err != nil {
+			return err
+		}
+		conn, optFns := objectTagsConn(ctx, meta, bucket)
+		tags, err = objectListTags(ctx, conn, bucket, sdkv1CompatibleCleanKey(key), versionID, optFns...)
 
 	default:
 		return nil
@@ -206,13 +282,45 @@ func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier, r
 		if err != nil {
 			return err
 		}
-		return objectUpdateTags(ctx, meta.(*conns.AWSClient).S3Client(ctx), objectARN.Bucket, objectARN.Key, oldTags, newTags)
+		return objectTagsInBucketRegion(ctx, meta, objectARN.Bucket, func(conn *s3.Client, optFns ...func(*s3.Options)) error {
+			return objectUpdateTags(ctx, conn, objectARN.Bucket, objectARN.Key, "", oldTags, newTags, optFns...)
+		})
+
+	case "ObjectTags":
+		bucket, key, versionID, err := parseObjectTagsResourceID(identifier)
+		if err != nil {
+			return err
+		}
+		conn, optFns := objectTagsConn(ctx, meta, bucket)
+		return objectUpdateTags(ctx, conn, bucket, sdkv1CompatibleCleanKey(key), versionID, oldTags, newTags, optFns...)
 
 	default:
 		return nil
 	}
 }
 
+// objectTagsInBucketRegion calls f with the S3 client and client options to use when tagging an object in the specified bucket.
+// As when the object is read, updated or deleted, requests via an access point ARN are sent to the ARN's region.
+// An object's ARN doesn't include its region, so if the bucket isn't in the client's region, e.g. for an aws_s3_object with region configured,
+// f is called again in the bucket's region.
+func objectTagsInBucketRegion(ctx context.Context, meta any, bucket string, f func(*s3.Client, ...func(*s3.Options)) error) error {
+	conn, optFns := objectTagsConn(ctx, meta, bucket)
+
+	err := f(conn, optFns...)
+
+	if !tfawserr.ErrCodeEquals(err, errCodeAuthorizationHeaderMalformed, errCodePermanentRedirect) {
+		return err
+	}
+
+	region, err := findBucketRegion(ctx, meta.(*conns.AWSClient), bucket, optFns...)
+
+	if err != nil {
+		return err
+	}
+
+	return f(conn, append(optFns, func(o *s3.Options) { o.Region = region })...)
+}
+
 func getContextTags(ctx context.Context) tftags.KeyValueTags {
 	if inContext, ok := tftags.FromContext(ctx); ok {
 		return inContext.TagsIn.UnwrapOrDefault()